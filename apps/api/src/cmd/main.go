@@ -1,6 +1,8 @@
 package main
 
 import (
+	"api/src/infra/rds"
+	"api/src/infra/rds/task_repository"
 	"api/src/routes"
 	"context"
 	"net/http"
@@ -8,6 +10,8 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	"utils/db/db"
+	"utils/db/postgres"
 	"utils/env"
 	"utils/logger"
 )
@@ -20,8 +24,21 @@ func main() {
 	// Get port from environment variable, default to 8080
 	port := env.GetString("PORT", "8080")
 
+	// Stop on SIGINT/SIGTERM, including while still waiting for the database
+	stop, cancelStop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancelStop()
+
+	// Connect to the database, waiting for it to accept connections
+	conn, err := postgres.Open(stop, postgres.ConfigFromEnv())
+	if err != nil {
+		logger.Error("Failed to connect to database: " + err.Error())
+		os.Exit(1)
+	}
+
 	// Create router
-	router := routes.NewRouter()
+	router := routes.NewRouter(routes.Dependencies{
+		TaskRepository: task_repository.New(db.New(conn), rds.NewTxBeginner(conn)),
+	})
 
 	// Configure server
 	srv := &http.Server{
@@ -42,9 +59,7 @@ func main() {
 	}()
 
 	// Wait for interrupt signal to gracefully shutdown the server
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
+	<-stop.Done()
 
 	logger.Info("Shutting down server...")
 
@@ -55,8 +70,14 @@ func main() {
 	// Attempt graceful shutdown
 	if err := srv.Shutdown(ctx); err != nil {
 		logger.Error("Server forced to shutdown: " + err.Error())
+		conn.Close()
 		os.Exit(1)
 	}
 
+	// Close the database pool once in-flight requests have finished
+	if err := conn.Close(); err != nil {
+		logger.Error("Failed to close database: " + err.Error())
+	}
+
 	logger.Info("Server exited")
 }
@@ -1,6 +1,13 @@
 package model
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime"
+	"slices"
+)
 
 // Error name constants define the canonical names for each error type.
 // These are used for error identification and logging.
@@ -13,6 +20,11 @@ const (
 	ConflictErrorName       = "ConflictError"
 	ForbiddenErrorName      = "ForbiddenError"
 	DatabaseErrorName       = "DatabaseError"
+	TimeoutErrorName        = "TimeoutError"
+	CanceledErrorName       = "CanceledError"
+
+	RateLimitedErrorName        = "RateLimitedError"
+	PreconditionFailedErrorName = "PreconditionFailedError"
 )
 
 // AppError is the common error interface for the application.
@@ -26,14 +38,73 @@ type AppError interface {
 	DomainName() string
 	// Unwrap returns the underlying error, if any.
 	Unwrap() error
+	// PublicMessage returns the message that may be shown to clients,
+	// or an empty string if none was set.
+	PublicMessage() string
+	// Attrs returns the key/value attributes attached to the error.
+	Attrs() []slog.Attr
+	// Stack returns the call stack captured when the error was created,
+	// or nil if capturing was not requested.
+	Stack() []runtime.Frame
+	// LogValue renders the error as a structured object for slog.
+	LogValue() slog.Value
 }
 
 // baseErr is the base error structure that implements AppError.
 // All specific error types embed this structure.
 type baseErr struct {
-	errName    string // The canonical name of the error type
-	domainName string // The domain or context where the error occurred
-	err        error  // The underlying error, if any
+	errName    string      // The canonical name of the error type
+	domainName string      // The domain or context where the error occurred
+	err        error       // The underlying error, if any
+	publicMsg  string      // The message safe to show to clients, if any
+	attrs      []slog.Attr // Key/value attributes describing the failure
+	stack      []uintptr   // Program counters of the creating call stack, if captured
+}
+
+// ErrorOption customizes an error when it is created.
+type ErrorOption func(*baseErr)
+
+// WithPublicMessage sets the message shown to clients in place of the
+// internal error text.
+func WithPublicMessage(msg string) ErrorOption {
+	return func(e *baseErr) {
+		e.publicMsg = msg
+	}
+}
+
+// WithAttrs attaches key/value attributes, such as the task ID or the query
+// name, to the error. args are interpreted as in slog.Logger.Log.
+func WithAttrs(args ...any) ErrorOption {
+	return func(e *baseErr) {
+		e.attrs = append(e.attrs, slog.Group("", args...).Value.Group()...)
+	}
+}
+
+// maxStackDepth bounds the number of frames captured by WithStack.
+const maxStackDepth = 32
+
+// WithStack captures the call stack of the code creating the error.
+// Capturing is comparatively expensive, so it is meant for errors that
+// indicate a fault, like DatabaseError, rather than for expected outcomes.
+func WithStack() ErrorOption {
+	return func(e *baseErr) {
+		pcs := make([]uintptr, maxStackDepth)
+		// Skip runtime.Callers, this closure, newBaseErr and the constructor.
+		e.stack = pcs[:runtime.Callers(4, pcs)]
+	}
+}
+
+// newBaseErr creates a baseErr and applies opts to it.
+func newBaseErr(errName string, err error, dName string, opts []ErrorOption) baseErr {
+	e := baseErr{
+		errName:    errName,
+		domainName: dName,
+		err:        err,
+	}
+	for _, opt := range opts {
+		opt(&e)
+	}
+	return e
 }
 
 // ErrorName returns the canonical name of the error type.
@@ -55,6 +126,54 @@ func (e baseErr) Error() string {
 	return fmt.Sprintf("%s [%s]", e.errName, e.domainName)
 }
 
+// PublicMessage returns the message safe to show to clients, if any.
+func (e baseErr) PublicMessage() string {
+	return e.publicMsg
+}
+
+// Attrs returns the key/value attributes attached to the error.
+func (e baseErr) Attrs() []slog.Attr {
+	return e.attrs
+}
+
+// Stack returns the call stack captured when the error was created,
+// or nil if capturing was not requested.
+func (e baseErr) Stack() []runtime.Frame {
+	if len(e.stack) == 0 {
+		return nil
+	}
+	frames := runtime.CallersFrames(e.stack)
+	var stack []runtime.Frame
+	for {
+		frame, more := frames.Next()
+		stack = append(stack, frame)
+		if !more {
+			return stack
+		}
+	}
+}
+
+// LogValue implements slog.LogValuer so that logging an error emits its
+// name, domain, message, attributes and stack as a single object.
+func (e baseErr) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.String("type", e.errName),
+		slog.String("domain", e.domainName),
+		slog.String("message", e.Error()),
+	}
+	if len(e.attrs) > 0 {
+		attrs = append(attrs, slog.Attr{Key: "attrs", Value: slog.GroupValue(e.attrs...)})
+	}
+	if stack := e.Stack(); len(stack) > 0 {
+		lines := make([]string, len(stack))
+		for i, f := range stack {
+			lines[i] = fmt.Sprintf("%s %s:%d", f.Function, f.File, f.Line)
+		}
+		attrs = append(attrs, slog.Any("stack", lines))
+	}
+	return slog.GroupValue(attrs...)
+}
+
 // Unwrap returns the underlying error for error chain support.
 // This enables the use of errors.Is and errors.As.
 func (e baseErr) Unwrap() error {
@@ -67,29 +186,59 @@ type NotFoundError struct {
 }
 
 // NewNotFoundError creates a new NotFoundError with the given underlying error and domain name.
-func NewNotFoundError(err error, dName string) NotFoundError {
+func NewNotFoundError(err error, dName string, opts ...ErrorOption) NotFoundError {
 	return NotFoundError{
-		baseErr: baseErr{
-			errName:    NotFoundErrorName,
-			domainName: dName,
-			err:        err,
-		},
+		baseErr: newBaseErr(NotFoundErrorName, err, dName, opts),
 	}
 }
 
+// FieldError describes why a single input field failed validation.
+type FieldError struct {
+	Field   string // Name of the field as the client sent it
+	Rule    string // Validation rule that failed, e.g. "required"
+	Param   string // Parameter of the rule, e.g. "3" for min=3; empty if the rule has none
+	Message string // Human-readable description of the failure
+}
+
 // ValidationError represents an error when input validation fails.
+// It optionally lists the individual fields that were rejected.
 type ValidationError struct {
 	baseErr
+	details []FieldError
+}
+
+// Details returns the per-field failures, or nil if none were recorded.
+func (e ValidationError) Details() []FieldError {
+	return e.details
+}
+
+// LogValue extends the base log object with the per-field failures so that
+// logs show which fields were rejected, not only the joined message.
+func (e ValidationError) LogValue() slog.Value {
+	attrs := e.baseErr.LogValue().Group()
+	if len(e.details) == 0 {
+		return slog.GroupValue(attrs...)
+	}
+	details := make([]map[string]string, len(e.details))
+	for i, d := range e.details {
+		details[i] = map[string]string{"field": d.Field, "rule": d.Rule, "message": d.Message}
+		if d.Param != "" {
+			details[i]["param"] = d.Param
+		}
+	}
+	return slog.GroupValue(append(attrs, slog.Any("details", details))...)
+}
+
+// WithDetails returns a copy of the error with details appended to its field failures.
+func (e ValidationError) WithDetails(details ...FieldError) ValidationError {
+	e.details = append(slices.Clip(e.details), details...)
+	return e
 }
 
 // NewValidationError creates a new ValidationError with the given underlying error and domain name.
-func NewValidationError(err error, dName string) ValidationError {
+func NewValidationError(err error, dName string, opts ...ErrorOption) ValidationError {
 	return ValidationError{
-		baseErr: baseErr{
-			errName:    ValidationErrorName,
-			domainName: dName,
-			err:        err,
-		},
+		baseErr: newBaseErr(ValidationErrorName, err, dName, opts),
 	}
 }
 
@@ -99,13 +248,9 @@ type UnauthorizedError struct {
 }
 
 // NewUnauthorizedError creates a new UnauthorizedError with the given underlying error and domain name.
-func NewUnauthorizedError(err error, dName string) UnauthorizedError {
+func NewUnauthorizedError(err error, dName string, opts ...ErrorOption) UnauthorizedError {
 	return UnauthorizedError{
-		baseErr: baseErr{
-			errName:    UnauthorizedErrorName,
-			domainName: dName,
-			err:        err,
-		},
+		baseErr: newBaseErr(UnauthorizedErrorName, err, dName, opts),
 	}
 }
 
@@ -115,13 +260,9 @@ type InternalServerError struct {
 }
 
 // NewInternalServerError creates a new InternalServerError with the given underlying error and domain name.
-func NewInternalServerError(err error, dName string) InternalServerError {
+func NewInternalServerError(err error, dName string, opts ...ErrorOption) InternalServerError {
 	return InternalServerError{
-		baseErr: baseErr{
-			errName:    InternalServerErrorName,
-			domainName: dName,
-			err:        err,
-		},
+		baseErr: newBaseErr(InternalServerErrorName, err, dName, opts),
 	}
 }
 
@@ -131,13 +272,9 @@ type BadRequestError struct {
 }
 
 // NewBadRequestError creates a new BadRequestError with the given underlying error and domain name.
-func NewBadRequestError(err error, dName string) BadRequestError {
+func NewBadRequestError(err error, dName string, opts ...ErrorOption) BadRequestError {
 	return BadRequestError{
-		baseErr: baseErr{
-			errName:    BadRequestErrorName,
-			domainName: dName,
-			err:        err,
-		},
+		baseErr: newBaseErr(BadRequestErrorName, err, dName, opts),
 	}
 }
 
@@ -147,13 +284,9 @@ type ConflictError struct {
 }
 
 // NewConflictError creates a new ConflictError with the given underlying error and domain name.
-func NewConflictError(err error, dName string) ConflictError {
+func NewConflictError(err error, dName string, opts ...ErrorOption) ConflictError {
 	return ConflictError{
-		baseErr: baseErr{
-			errName:    ConflictErrorName,
-			domainName: dName,
-			err:        err,
-		},
+		baseErr: newBaseErr(ConflictErrorName, err, dName, opts),
 	}
 }
 
@@ -163,13 +296,9 @@ type ForbiddenError struct {
 }
 
 // NewForbiddenError creates a new ForbiddenError with the given underlying error and domain name.
-func NewForbiddenError(err error, dName string) ForbiddenError {
+func NewForbiddenError(err error, dName string, opts ...ErrorOption) ForbiddenError {
 	return ForbiddenError{
-		baseErr: baseErr{
-			errName:    ForbiddenErrorName,
-			domainName: dName,
-			err:        err,
-		},
+		baseErr: newBaseErr(ForbiddenErrorName, err, dName, opts),
 	}
 }
 
@@ -179,12 +308,66 @@ type DatabaseError struct {
 }
 
 // NewDatabaseError creates a new DatabaseError with the given underlying error and domain name.
-func NewDatabaseError(err error, dName string) DatabaseError {
+func NewDatabaseError(err error, dName string, opts ...ErrorOption) DatabaseError {
 	return DatabaseError{
-		baseErr: baseErr{
-			errName:    DatabaseErrorName,
-			domainName: dName,
-			err:        err,
-		},
+		baseErr: newBaseErr(DatabaseErrorName, err, dName, opts),
+	}
+}
+
+// TimeoutError represents an error when an operation did not finish before its deadline.
+type TimeoutError struct {
+	baseErr
+}
+
+// NewTimeoutError creates a new TimeoutError with the given underlying error and domain name.
+func NewTimeoutError(err error, dName string, opts ...ErrorOption) TimeoutError {
+	return TimeoutError{
+		baseErr: newBaseErr(TimeoutErrorName, err, dName, opts),
+	}
+}
+
+// CanceledError represents an error when an operation was abandoned because the caller went away.
+type CanceledError struct {
+	baseErr
+}
+
+// NewCanceledError creates a new CanceledError with the given underlying error and domain name.
+func NewCanceledError(err error, dName string, opts ...ErrorOption) CanceledError {
+	return CanceledError{
+		baseErr: newBaseErr(CanceledErrorName, err, dName, opts),
+	}
+}
+
+// RateLimitedError represents an error when the caller has sent too many requests.
+type RateLimitedError struct {
+	baseErr
+}
+
+// NewRateLimitedError creates a new RateLimitedError with the given underlying error and domain name.
+func NewRateLimitedError(err error, dName string, opts ...ErrorOption) RateLimitedError {
+	return RateLimitedError{
+		baseErr: newBaseErr(RateLimitedErrorName, err, dName, opts),
+	}
+}
+
+// PreconditionFailedError represents an error when a condition of the request,
+// such as an expected version, no longer holds.
+type PreconditionFailedError struct {
+	baseErr
+}
+
+// NewPreconditionFailedError creates a new PreconditionFailedError with the given underlying error and domain name.
+func NewPreconditionFailedError(err error, dName string, opts ...ErrorOption) PreconditionFailedError {
+	return PreconditionFailedError{
+		baseErr: newBaseErr(PreconditionFailedErrorName, err, dName, opts),
+	}
+}
+
+// NewContextError converts the cause of a finished context into a TimeoutError
+// when the deadline passed and a CanceledError otherwise.
+func NewContextError(cause error, dName string, opts ...ErrorOption) AppError {
+	if errors.Is(cause, context.DeadlineExceeded) {
+		return NewTimeoutError(cause, dName, opts...)
 	}
+	return NewCanceledError(cause, dName, opts...)
 }
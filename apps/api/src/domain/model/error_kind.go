@@ -0,0 +1,111 @@
+package model
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+)
+
+// GRPCCode is a gRPC status code. The values match google.golang.org/grpc/codes,
+// so converting with codes.Code(c) is lossless.
+type GRPCCode uint32
+
+const (
+	GRPCCodeCanceled           GRPCCode = 1
+	GRPCCodeUnknown            GRPCCode = 2
+	GRPCCodeInvalidArgument    GRPCCode = 3
+	GRPCCodeDeadlineExceeded   GRPCCode = 4
+	GRPCCodeNotFound           GRPCCode = 5
+	GRPCCodeAlreadyExists      GRPCCode = 6
+	GRPCCodePermissionDenied   GRPCCode = 7
+	GRPCCodeResourceExhausted  GRPCCode = 8
+	GRPCCodeFailedPrecondition GRPCCode = 9
+	GRPCCodeAborted            GRPCCode = 10
+	GRPCCodeInternal           GRPCCode = 13
+	GRPCCodeUnavailable        GRPCCode = 14
+	GRPCCodeUnauthenticated    GRPCCode = 16
+)
+
+// StatusClientClosedRequest is nginx's non-standard status for a client that
+// disconnected before the response was written.
+const StatusClientClosedRequest = 499
+
+// ErrorKind describes how errors with a given ErrorName are reported to
+// clients and operators.
+type ErrorKind struct {
+	Name       string     // The canonical error name, as returned by AppError.ErrorName
+	Code       string     // Stable machine-readable code, e.g. "not_found"
+	HTTPStatus int        // Status of HTTP responses for this kind
+	GRPCCode   GRPCCode   // Status code of gRPC responses for this kind
+	LogLevel   slog.Level // Level at which occurrences are logged
+	Retryable  bool       // Whether retrying the same request may succeed
+}
+
+// UnknownErrorKind describes errors whose name has not been registered.
+var UnknownErrorKind = ErrorKind{
+	Name:       "UnknownError",
+	Code:       "unknown",
+	HTTPStatus: http.StatusInternalServerError,
+	GRPCCode:   GRPCCodeUnknown,
+	LogLevel:   slog.LevelError,
+}
+
+var (
+	errorKindsMu sync.RWMutex
+	errorKinds   = map[string]ErrorKind{}
+)
+
+// RegisterErrorKind adds k to the registry so that errors named k.Name are
+// reported accordingly. It panics if the name or code is already registered,
+// and is meant to be called from package initialization.
+func RegisterErrorKind(k ErrorKind) {
+	errorKindsMu.Lock()
+	defer errorKindsMu.Unlock()
+	for _, existing := range errorKinds {
+		if existing.Name == k.Name || existing.Code == k.Code {
+			panic(fmt.Sprintf("model: error kind %s (%s) already registered", k.Name, k.Code))
+		}
+	}
+	errorKinds[k.Name] = k
+}
+
+// LookupErrorKind returns the registered kind for the error name.
+func LookupErrorKind(name string) (ErrorKind, bool) {
+	errorKindsMu.RLock()
+	defer errorKindsMu.RUnlock()
+	k, ok := errorKinds[name]
+	return k, ok
+}
+
+// KindOf returns the registered kind of err, or UnknownErrorKind.
+func KindOf(err AppError) ErrorKind {
+	if k, ok := LookupErrorKind(err.ErrorName()); ok {
+		return k
+	}
+	return UnknownErrorKind
+}
+
+// IsRetryable reports whether retrying the operation that failed with err may succeed.
+func IsRetryable(err AppError) bool {
+	return KindOf(err).Retryable
+}
+
+func init() {
+	for _, k := range []ErrorKind{
+		{NotFoundErrorName, "not_found", http.StatusNotFound, GRPCCodeNotFound, slog.LevelDebug, false},
+		{ValidationErrorName, "validation_failed", http.StatusBadRequest, GRPCCodeInvalidArgument, slog.LevelDebug, false},
+		{UnauthorizedErrorName, "unauthorized", http.StatusUnauthorized, GRPCCodeUnauthenticated, slog.LevelInfo, false},
+		{ForbiddenErrorName, "forbidden", http.StatusForbidden, GRPCCodePermissionDenied, slog.LevelInfo, false},
+		{BadRequestErrorName, "bad_request", http.StatusBadRequest, GRPCCodeInvalidArgument, slog.LevelDebug, false},
+		{ConflictErrorName, "conflict", http.StatusConflict, GRPCCodeAborted, slog.LevelInfo, false},
+		{PreconditionFailedErrorName, "precondition_failed", http.StatusPreconditionFailed, GRPCCodeFailedPrecondition, slog.LevelInfo, false},
+		{RateLimitedErrorName, "rate_limited", http.StatusTooManyRequests, GRPCCodeResourceExhausted, slog.LevelWarn, true},
+		{CanceledErrorName, "canceled", StatusClientClosedRequest, GRPCCodeCanceled, slog.LevelInfo, false},
+		{TimeoutErrorName, "timeout", http.StatusGatewayTimeout, GRPCCodeDeadlineExceeded, slog.LevelWarn, true},
+		{DatabaseErrorName, "database_error", http.StatusInternalServerError, GRPCCodeUnavailable, slog.LevelError, false},
+		{InternalServerErrorName, "internal", http.StatusInternalServerError, GRPCCodeInternal, slog.LevelError, false},
+	} {
+		RegisterErrorKind(k)
+	}
+}
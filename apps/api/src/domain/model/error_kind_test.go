@@ -0,0 +1,97 @@
+package model
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestKindOf(t *testing.T) {
+	type args struct {
+		err AppError
+	}
+	type expected struct {
+		code       string
+		httpStatus int
+		grpcCode   GRPCCode
+		retryable  bool
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "not found",
+			args:     args{err: NewNotFoundError(nil, "TestDomain")},
+			expected: expected{code: "not_found", httpStatus: http.StatusNotFound, grpcCode: GRPCCodeNotFound},
+		},
+		{
+			testName: "rate limited",
+			args:     args{err: NewRateLimitedError(nil, "TestDomain")},
+			expected: expected{code: "rate_limited", httpStatus: http.StatusTooManyRequests, grpcCode: GRPCCodeResourceExhausted, retryable: true},
+		},
+		{
+			testName: "precondition failed",
+			args:     args{err: NewPreconditionFailedError(nil, "TestDomain")},
+			expected: expected{code: "precondition_failed", httpStatus: http.StatusPreconditionFailed, grpcCode: GRPCCodeFailedPrecondition},
+		},
+		{
+			testName: "database",
+			args:     args{err: NewDatabaseError(errors.New("connection refused"), "TestDomain")},
+			expected: expected{code: "database_error", httpStatus: http.StatusInternalServerError, grpcCode: GRPCCodeUnavailable},
+		},
+		{
+			testName: "unregistered name",
+			args:     args{err: unregisteredError{NewInternalServerError(nil, "TestDomain")}},
+			expected: expected{code: UnknownErrorKind.Code, httpStatus: http.StatusInternalServerError, grpcCode: GRPCCodeUnknown},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			k := KindOf(tt.args.err)
+			if k.Code != tt.expected.code {
+				t.Errorf("expected code %q, got %q", tt.expected.code, k.Code)
+			}
+			if k.HTTPStatus != tt.expected.httpStatus {
+				t.Errorf("expected status %d, got %d", tt.expected.httpStatus, k.HTTPStatus)
+			}
+			if k.GRPCCode != tt.expected.grpcCode {
+				t.Errorf("expected gRPC code %d, got %d", tt.expected.grpcCode, k.GRPCCode)
+			}
+			if k.Retryable != tt.expected.retryable {
+				t.Errorf("expected retryable %v, got %v", tt.expected.retryable, k.Retryable)
+			}
+		})
+	}
+}
+
+func TestRegisterErrorKindDuplicate(t *testing.T) {
+	tests := []struct {
+		testName string
+		kind     ErrorKind
+	}{
+		{testName: "duplicate name", kind: ErrorKind{Name: NotFoundErrorName, Code: "missing"}},
+		{testName: "duplicate code", kind: ErrorKind{Name: "MissingError", Code: "not_found"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected panic")
+				}
+			}()
+			RegisterErrorKind(tt.kind)
+		})
+	}
+}
+
+// unregisteredError reports a name that has no registered kind.
+type unregisteredError struct {
+	AppError
+}
+
+func (unregisteredError) ErrorName() string { return "UnregisteredError" }
@@ -0,0 +1,153 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestErrorOptions(t *testing.T) {
+	type args struct {
+		opts []ErrorOption
+	}
+	type expected struct {
+		attrs     map[string]any
+		hasStack  bool
+		publicMsg string
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "no options",
+			args:     args{},
+			expected: expected{attrs: map[string]any{}},
+		},
+		{
+			testName: "attributes",
+			args: args{
+				opts: []ErrorOption{
+					WithAttrs("task_id", "1", "query", "GetTask"),
+					WithAttrs(slog.Int("attempt", 2)),
+				},
+			},
+			expected: expected{attrs: map[string]any{"task_id": "1", "query": "GetTask", "attempt": int64(2)}},
+		},
+		{
+			testName: "stack and public message",
+			args: args{
+				opts: []ErrorOption{WithStack(), WithPublicMessage("try again later")},
+			},
+			expected: expected{attrs: map[string]any{}, hasStack: true, publicMsg: "try again later"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			err := NewDatabaseError(errors.New("connection refused"), "TestDomain", tt.args.opts...)
+
+			attrs := map[string]any{}
+			for _, a := range err.Attrs() {
+				attrs[a.Key] = a.Value.Any()
+			}
+			if len(attrs) != len(tt.expected.attrs) {
+				t.Errorf("expected attrs %v, got %v", tt.expected.attrs, attrs)
+			}
+			for k, v := range tt.expected.attrs {
+				if attrs[k] != v {
+					t.Errorf("expected attr %s %v, got %v", k, v, attrs[k])
+				}
+			}
+
+			stack := err.Stack()
+			if (len(stack) > 0) != tt.expected.hasStack {
+				t.Fatalf("expected stack %v, got %d frames", tt.expected.hasStack, len(stack))
+			}
+			if tt.expected.hasStack && !strings.Contains(stack[0].Function, "TestErrorOptions") {
+				t.Errorf("expected stack to start at the caller, got %s", stack[0].Function)
+			}
+
+			if err.PublicMessage() != tt.expected.publicMsg {
+				t.Errorf("expected public message %q, got %q", tt.expected.publicMsg, err.PublicMessage())
+			}
+		})
+	}
+}
+
+func TestErrorLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	log := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	err := NewDatabaseError(errors.New("connection refused"), "TaskRepository",
+		WithAttrs("task_id", "1"), WithStack())
+	log.Error("query failed", "err", err)
+
+	var entry struct {
+		Err struct {
+			Type    string            `json:"type"`
+			Domain  string            `json:"domain"`
+			Message string            `json:"message"`
+			Attrs   map[string]string `json:"attrs"`
+			Stack   []string          `json:"stack"`
+		} `json:"err"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("failed to decode log entry %s: %v", buf.String(), err)
+	}
+
+	if entry.Err.Type != DatabaseErrorName {
+		t.Errorf("expected type %s, got %s", DatabaseErrorName, entry.Err.Type)
+	}
+	if entry.Err.Domain != "TaskRepository" {
+		t.Errorf("expected domain TaskRepository, got %s", entry.Err.Domain)
+	}
+	if entry.Err.Message != err.Error() {
+		t.Errorf("expected message %q, got %q", err.Error(), entry.Err.Message)
+	}
+	if entry.Err.Attrs["task_id"] != "1" {
+		t.Errorf("expected task_id attr 1, got %v", entry.Err.Attrs)
+	}
+	if len(entry.Err.Stack) == 0 || !strings.Contains(entry.Err.Stack[0], "TestErrorLogValue") {
+		t.Errorf("expected stack starting at the caller, got %v", entry.Err.Stack)
+	}
+}
+
+func TestValidationErrorLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	log := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	err := NewValidationError(errors.New("title is too short"), "postRequest").WithDetails(
+		FieldError{Field: "title", Rule: "min", Param: "3", Message: "title must be at least 3 characters"},
+		FieldError{Field: "priority", Rule: "oneof", Message: "priority is invalid"},
+	)
+	log.Warn("request failed", "err", err)
+
+	var entry struct {
+		Err struct {
+			Type    string              `json:"type"`
+			Details []map[string]string `json:"details"`
+		} `json:"err"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("failed to decode log entry %s: %v", buf.String(), err)
+	}
+
+	if entry.Err.Type != ValidationErrorName {
+		t.Errorf("expected type %s, got %s", ValidationErrorName, entry.Err.Type)
+	}
+	if len(entry.Err.Details) != 2 {
+		t.Fatalf("expected 2 details, got %v", entry.Err.Details)
+	}
+	if d := entry.Err.Details[0]; d["field"] != "title" || d["rule"] != "min" || d["param"] != "3" {
+		t.Errorf("unexpected title detail %v", d)
+	}
+	if _, ok := entry.Err.Details[1]["param"]; ok {
+		t.Errorf("expected no param for priority, got %v", entry.Err.Details[1])
+	}
+}
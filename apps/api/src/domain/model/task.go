@@ -1,6 +1,13 @@
 package model
 
-import "github.com/google/uuid"
+import (
+	"fmt"
+	"slices"
+	"time"
+	"utils/types"
+
+	"github.com/google/uuid"
+)
 
 // TaskID represents a unique identifier for a task.
 // It wraps a UUID to ensure type safety.
@@ -35,27 +42,207 @@ func (t TaskDescription) String() string {
 	return string(t)
 }
 
-// TaskCompleted represents the completion status of a task.
-// True indicates the task is completed, false indicates it is pending.
-type TaskCompleted bool
+// TaskStatus represents the lifecycle state of a task.
+// Only the values declared below are valid.
+type TaskStatus string
+
+const (
+	TaskStatusPending    TaskStatus = "pending"
+	TaskStatusInProgress TaskStatus = "in_progress"
+	TaskStatusCompleted  TaskStatus = "completed"
+	TaskStatusCancelled  TaskStatus = "cancelled"
+)
+
+// TaskStatuses lists every valid TaskStatus.
+var TaskStatuses = []TaskStatus{
+	TaskStatusPending,
+	TaskStatusInProgress,
+	TaskStatusCompleted,
+	TaskStatusCancelled,
+}
+
+// NewTaskStatus creates a TaskStatus from its string representation.
+// It returns a ValidationError if the value is not one of TaskStatuses.
+func NewTaskStatus(s string) types.Result[TaskStatus, AppError] {
+	status := TaskStatus(s)
+	if !slices.Contains(TaskStatuses, status) {
+		return types.Err[TaskStatus, AppError](
+			NewValidationError(fmt.Errorf("invalid task status %q", s), "TaskStatus"),
+		)
+	}
+	return types.Ok[TaskStatus, AppError](status)
+}
+
+// String returns the string representation of the TaskStatus.
+func (t TaskStatus) String() string {
+	return string(t)
+}
+
+// TaskPriority represents the urgency of a task.
+// Only the values declared below are valid.
+type TaskPriority string
 
-// Bool returns the boolean value of the TaskCompleted status.
-func (t TaskCompleted) Bool() bool {
-	return bool(t)
+const (
+	TaskPriorityLow    TaskPriority = "low"
+	TaskPriorityMedium TaskPriority = "medium"
+	TaskPriorityHigh   TaskPriority = "high"
+)
+
+// TaskPriorities lists every valid TaskPriority.
+var TaskPriorities = []TaskPriority{
+	TaskPriorityLow,
+	TaskPriorityMedium,
+	TaskPriorityHigh,
+}
+
+// NewTaskPriority creates a TaskPriority from its string representation.
+// It returns a ValidationError if the value is not one of TaskPriorities.
+func NewTaskPriority(s string) types.Result[TaskPriority, AppError] {
+	priority := TaskPriority(s)
+	if !slices.Contains(TaskPriorities, priority) {
+		return types.Err[TaskPriority, AppError](
+			NewValidationError(fmt.Errorf("invalid task priority %q", s), "TaskPriority"),
+		)
+	}
+	return types.Ok[TaskPriority, AppError](priority)
+}
+
+// String returns the string representation of the TaskPriority.
+func (t TaskPriority) String() string {
+	return string(t)
+}
+
+// TaskDueDate represents the deadline of a task.
+type TaskDueDate time.Time
+
+// Time returns the time.Time value of the TaskDueDate.
+func (t TaskDueDate) Time() time.Time {
+	return time.Time(t)
 }
 
 // Task represents a task entity in the domain model.
 // It contains all the properties that define a task.
+// Description, DueDate, CompletedAt and UserID are None when unset.
 type Task struct {
 	ID          TaskID
 	Title       TaskTitle
-	Description TaskDescription
-	Completed   TaskCompleted
+	Description types.Option[TaskDescription]
+	Status      TaskStatus
+	Priority    TaskPriority
+	DueDate     types.Option[TaskDueDate]
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	CompletedAt types.Option[time.Time]
+	UserID      types.Option[UserID]
+}
+
+// IsCompleted reports whether the task has reached the completed status.
+func (t Task) IsCompleted() bool {
+	return t.Status == TaskStatusCompleted
 }
 
 // TaskCmd represents a command to create or update a task.
-// It contains only the mutable properties of a task (excluding ID).
+// It contains only the mutable properties of a task (excluding ID and Status,
+// which changes only through the status transition rules).
+// A zero Priority means "not specified", letting the repository apply the
+// default on create or keep the stored value on update. None options are
+// stored as unset on both create and update.
 type TaskCmd struct {
 	Title       TaskTitle
-	Description TaskDescription
+	Description types.Option[TaskDescription]
+	Priority    TaskPriority
+	DueDate     types.Option[TaskDueDate]
+	UserID      types.Option[UserID]
+}
+
+// TaskFilter represents the criteria for listing tasks.
+// A zero Status or Priority and a None UserID mean "any".
+type TaskFilter struct {
+	Status   TaskStatus
+	Priority TaskPriority
+	UserID   types.Option[UserID]
+}
+
+// Matches reports whether the task satisfies every criterion of the filter.
+func (f TaskFilter) Matches(t Task) bool {
+	if f.Status != "" && t.Status != f.Status {
+		return false
+	}
+	if f.Priority != "" && t.Priority != f.Priority {
+		return false
+	}
+	if want, ok := f.UserID.Get(); ok && t.UserID != types.Some(want) {
+		return false
+	}
+	return true
+}
+
+const (
+	// DefaultTaskPageSize is the number of tasks per page when no limit is requested.
+	DefaultTaskPageSize = 20
+	// MaxTaskPageSize is the hard upper bound on the number of tasks per page.
+	MaxTaskPageSize = 100
+)
+
+// TaskCursor identifies a position in the task listing order.
+// Tasks are ordered by CreatedAt, then ID, both descending.
+type TaskCursor struct {
+	CreatedAt time.Time
+	ID        TaskID
+}
+
+// TaskPageRequest represents a request for one page of tasks.
+// After is nil for the first page.
+type TaskPageRequest struct {
+	Limit int
+	After *TaskCursor
+}
+
+// Size returns the effective page size. A non-positive Limit falls back to
+// DefaultTaskPageSize and any Limit above MaxTaskPageSize is capped.
+func (p TaskPageRequest) Size() int {
+	if p.Limit <= 0 {
+		return DefaultTaskPageSize
+	}
+	return min(p.Limit, MaxTaskPageSize)
+}
+
+// TaskPage represents one page of tasks.
+// Next is the cursor of the following page, or nil on the last page.
+type TaskPage struct {
+	Tasks []Task
+	Next  *TaskCursor
+}
+
+// TaskStats represents aggregate counts over a set of tasks.
+// ByStatus and ByPriority contain an entry for every valid value, even when zero.
+type TaskStats struct {
+	Total      int64
+	ByStatus   map[TaskStatus]int64
+	ByPriority map[TaskPriority]int64
+	Overdue    int64
+}
+
+// NewTaskStats creates an empty TaskStats with every status and priority set to zero.
+func NewTaskStats() TaskStats {
+	stats := TaskStats{
+		ByStatus:   make(map[TaskStatus]int64, len(TaskStatuses)),
+		ByPriority: make(map[TaskPriority]int64, len(TaskPriorities)),
+	}
+	for _, s := range TaskStatuses {
+		stats.ByStatus[s] = 0
+	}
+	for _, p := range TaskPriorities {
+		stats.ByPriority[p] = 0
+	}
+	return stats
+}
+
+// CompletionRate returns the fraction of tasks that are completed,
+// or 0 when there are no tasks.
+func (s TaskStats) CompletionRate() float64 {
+	if s.Total == 0 {
+		return 0
+	}
+	return float64(s.ByStatus[TaskStatusCompleted]) / float64(s.Total)
 }
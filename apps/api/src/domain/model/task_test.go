@@ -0,0 +1,111 @@
+package model
+
+import "testing"
+
+func TestNewTaskStatus(t *testing.T) {
+	type args struct {
+		status string
+	}
+	type expected struct {
+		hasError bool
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "pending",
+			args:     args{status: "pending"},
+			expected: expected{hasError: false},
+		},
+		{
+			testName: "in progress",
+			args:     args{status: "in_progress"},
+			expected: expected{hasError: false},
+		},
+		{
+			testName: "unknown status",
+			args:     args{status: "done"},
+			expected: expected{hasError: true},
+		},
+		{
+			testName: "empty status",
+			args:     args{status: ""},
+			expected: expected{hasError: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			result := NewTaskStatus(tt.args.status)
+
+			if tt.expected.hasError && result.IsOk() {
+				t.Errorf("expected validation error but got none")
+			}
+			if !tt.expected.hasError && !result.IsOk() {
+				t.Errorf("expected no validation error but got one")
+			}
+		})
+	}
+}
+
+func TestNewTaskPriority(t *testing.T) {
+	type args struct {
+		priority string
+	}
+	type expected struct {
+		hasError bool
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "high",
+			args:     args{priority: "high"},
+			expected: expected{hasError: false},
+		},
+		{
+			testName: "unknown priority",
+			args:     args{priority: "critical"},
+			expected: expected{hasError: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			result := NewTaskPriority(tt.args.priority)
+
+			if tt.expected.hasError && result.IsOk() {
+				t.Errorf("expected validation error but got none")
+			}
+			if !tt.expected.hasError && !result.IsOk() {
+				t.Errorf("expected no validation error but got one")
+			}
+		})
+	}
+}
+
+func TestTaskPageRequestSize(t *testing.T) {
+	tests := []struct {
+		testName string
+		limit    int
+		expected int
+	}{
+		{testName: "default", limit: 0, expected: DefaultTaskPageSize},
+		{testName: "requested", limit: 5, expected: 5},
+		{testName: "capped", limit: MaxTaskPageSize + 1, expected: MaxTaskPageSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			if got := (TaskPageRequest{Limit: tt.limit}).Size(); got != tt.expected {
+				t.Errorf("expected size %d, got %d", tt.expected, got)
+			}
+		})
+	}
+}
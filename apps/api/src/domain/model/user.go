@@ -0,0 +1,18 @@
+package model
+
+import "github.com/google/uuid"
+
+// UserID represents a unique identifier for a user.
+// It wraps a UUID to ensure type safety.
+type UserID uuid.UUID
+
+// NewUserID creates a new UserID from a string representation of a UUID.
+// It panics if the provided string is not a valid UUID.
+func NewUserID(id string) UserID {
+	return UserID(uuid.MustParse(id))
+}
+
+// String returns the string representation of the UserID.
+func (u UserID) String() string {
+	return uuid.UUID(u).String()
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"api/src/domain/model"
+	"context"
+	"time"
+	"utils/types"
+)
+
+// TaskStatusTransition decides the next status of a task from its current one.
+type TaskStatusTransition func(current model.TaskStatus) types.Result[model.TaskStatus, model.AppError]
+
+// TaskRepository defines the persistence operations required by the task handlers.
+// Implementations may be backed by Postgres, an in-memory store, or a decorator
+// such as a cache wrapping another TaskRepository.
+type TaskRepository interface {
+	// FindTaskByID returns the task with the given ID or a NotFoundError.
+	FindTaskByID(ctx context.Context, id model.TaskID) types.Result[model.Task, model.AppError]
+	// FindTasks returns one page of the tasks matching filter, newest first.
+	FindTasks(ctx context.Context, filter model.TaskFilter, page model.TaskPageRequest) types.Result[model.TaskPage, model.AppError]
+	// FindOverdueTasks returns at most limit open tasks whose due date has passed, earliest first.
+	// limit is capped at model.MaxTaskPageSize.
+	FindOverdueTasks(ctx context.Context, limit int) types.Result[[]model.Task, model.AppError]
+	// FindUpcomingTasks returns at most limit open tasks due between now and until, earliest first.
+	// limit is capped at model.MaxTaskPageSize.
+	FindUpcomingTasks(ctx context.Context, until time.Time, limit int) types.Result[[]model.Task, model.AppError]
+	// GetTaskStats returns aggregate counts over all tasks, or only those owned
+	// by userID when it is Some.
+	GetTaskStats(ctx context.Context, userID types.Option[model.UserID]) types.Result[model.TaskStats, model.AppError]
+	// CreateTask stores a new task built from cmd and returns it.
+	CreateTask(ctx context.Context, cmd model.TaskCmd) types.Result[model.Task, model.AppError]
+	// UpdateTask replaces the fields of an existing task with those of cmd and returns it.
+	// A missing description or due date clears the stored one; an empty priority keeps the current priority.
+	// The owner of a task cannot be changed, so cmd.UserID is ignored.
+	UpdateTask(ctx context.Context, id model.TaskID, cmd model.TaskCmd) types.Result[model.Task, model.AppError]
+	// UpdateTaskStatus atomically reads the current status of an existing task,
+	// asks transition for the new one and stores it. The task is left unchanged
+	// when transition returns an error.
+	UpdateTaskStatus(ctx context.Context, id model.TaskID, transition TaskStatusTransition) types.Result[model.Task, model.AppError]
+	// DeleteTask removes the task with the given ID or returns a NotFoundError.
+	DeleteTask(ctx context.Context, id model.TaskID) types.Result[model.TaskID, model.AppError]
+}
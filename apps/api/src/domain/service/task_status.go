@@ -0,0 +1,54 @@
+package service
+
+import (
+	"api/src/domain/model"
+	"fmt"
+	"slices"
+	"utils/types"
+)
+
+// taskStatusTransitions defines the task lifecycle as the set of statuses
+// reachable from each status.
+//
+//	pending -> in_progress -> completed
+//	   |            |
+//	   +------------+-------> cancelled
+//
+// A task in progress can be put back to pending, a completed task can be
+// reopened to in_progress and a cancelled task can be reopened to pending.
+var taskStatusTransitions = map[model.TaskStatus][]model.TaskStatus{
+	model.TaskStatusPending: {
+		model.TaskStatusInProgress,
+		model.TaskStatusCancelled,
+	},
+	model.TaskStatusInProgress: {
+		model.TaskStatusPending,
+		model.TaskStatusCompleted,
+		model.TaskStatusCancelled,
+	},
+	model.TaskStatusCompleted: {
+		model.TaskStatusInProgress,
+	},
+	model.TaskStatusCancelled: {
+		model.TaskStatusPending,
+	},
+}
+
+// CanTransitionTaskStatus reports whether a task may move from one status to another.
+func CanTransitionTaskStatus(from, to model.TaskStatus) bool {
+	return slices.Contains(taskStatusTransitions[from], to)
+}
+
+// TransitionTaskStatus validates moving a task from one status to another.
+// It returns the target status, or a ConflictError if the transition is not allowed.
+func TransitionTaskStatus(from, to model.TaskStatus) types.Result[model.TaskStatus, model.AppError] {
+	if !CanTransitionTaskStatus(from, to) {
+		return types.Err[model.TaskStatus, model.AppError](
+			model.NewConflictError(
+				fmt.Errorf("cannot transition task status from %q to %q", from, to),
+				"TaskStatus",
+			),
+		)
+	}
+	return types.Ok[model.TaskStatus, model.AppError](to)
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"api/src/domain/model"
+	"testing"
+)
+
+func TestTransitionTaskStatus(t *testing.T) {
+	type args struct {
+		from model.TaskStatus
+		to   model.TaskStatus
+	}
+	type expected struct {
+		errName string
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "start pending task",
+			args:     args{from: model.TaskStatusPending, to: model.TaskStatusInProgress},
+			expected: expected{},
+		},
+		{
+			testName: "complete task in progress",
+			args:     args{from: model.TaskStatusInProgress, to: model.TaskStatusCompleted},
+			expected: expected{},
+		},
+		{
+			testName: "cancel pending task",
+			args:     args{from: model.TaskStatusPending, to: model.TaskStatusCancelled},
+			expected: expected{},
+		},
+		{
+			testName: "reopen completed task",
+			args:     args{from: model.TaskStatusCompleted, to: model.TaskStatusInProgress},
+			expected: expected{},
+		},
+		{
+			testName: "reopen cancelled task",
+			args:     args{from: model.TaskStatusCancelled, to: model.TaskStatusPending},
+			expected: expected{},
+		},
+		{
+			testName: "complete pending task",
+			args:     args{from: model.TaskStatusPending, to: model.TaskStatusCompleted},
+			expected: expected{errName: model.ConflictErrorName},
+		},
+		{
+			testName: "complete cancelled task",
+			args:     args{from: model.TaskStatusCancelled, to: model.TaskStatusCompleted},
+			expected: expected{errName: model.ConflictErrorName},
+		},
+		{
+			testName: "same status",
+			args:     args{from: model.TaskStatusInProgress, to: model.TaskStatusInProgress},
+			expected: expected{errName: model.ConflictErrorName},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			result := TransitionTaskStatus(tt.args.from, tt.args.to)
+
+			result.Match(
+				func(status model.TaskStatus) {
+					if tt.expected.errName != "" {
+						t.Errorf("expected %s but got status %s", tt.expected.errName, status)
+					}
+				},
+				func(e model.AppError) {
+					if e.ErrorName() != tt.expected.errName {
+						t.Errorf("expected error %q, got %q", tt.expected.errName, e.ErrorName())
+					}
+				},
+			)
+		})
+	}
+}
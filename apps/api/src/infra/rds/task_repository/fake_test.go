@@ -0,0 +1,266 @@
+package task_repository
+
+import (
+	"api/src/infra/rds"
+	"bytes"
+	"cmp"
+	"context"
+	"database/sql"
+	"maps"
+	"slices"
+	"time"
+	"utils/db/db"
+
+	"github.com/google/uuid"
+)
+
+// fakeQuerier is an in-memory db.Querier used by the repository tests.
+type fakeQuerier struct {
+	tasks map[uuid.UUID]db.Task
+}
+
+var _ db.Querier = (*fakeQuerier)(nil)
+
+func newFakeQuerier(tasks ...db.Task) *fakeQuerier {
+	q := &fakeQuerier{tasks: map[uuid.UUID]db.Task{}}
+	for _, t := range tasks {
+		q.tasks[t.ID] = t
+	}
+	return q
+}
+
+// newTestRepository creates a Repository whose transactions run against q
+// and whose retries do not wait.
+func newTestRepository(q db.Querier) Repository {
+	r := New(q, fakeTxBeginner{q: q})
+	r.retry.Clock = instantClock{}
+	return r
+}
+
+// instantClock is a types.Clock whose waits end immediately.
+type instantClock struct{}
+
+func (instantClock) After(time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	ch <- time.Time{}
+	return ch
+}
+
+// fakeTxBeginner starts fakeTx transactions over q. When q is a *fakeQuerier,
+// rolling back restores the tasks it held when the transaction began.
+type fakeTxBeginner struct {
+	q db.Querier
+}
+
+func (b fakeTxBeginner) BeginTx(ctx context.Context, opts *sql.TxOptions) (rds.Tx, error) {
+	tx := &fakeTx{q: b.q}
+	if fq, ok := b.q.(*fakeQuerier); ok {
+		tx.snapshot = maps.Clone(fq.tasks)
+	}
+	return tx, nil
+}
+
+type fakeTx struct {
+	q        db.Querier
+	snapshot map[uuid.UUID]db.Task
+}
+
+func (tx *fakeTx) Querier() db.Querier { return tx.q }
+func (tx *fakeTx) Commit() error       { return nil }
+
+func (tx *fakeTx) Rollback() error {
+	if fq, ok := tx.q.(*fakeQuerier); ok {
+		fq.tasks = tx.snapshot
+	}
+	return nil
+}
+
+func newFakeTask(id string) db.Task {
+	now := time.Now()
+	return db.Task{
+		ID:        uuid.MustParse(id),
+		Title:     "Sample Task",
+		Status:    "pending",
+		Priority:  "medium",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func (q *fakeQuerier) all(match func(db.Task) bool) []db.Task {
+	var items []db.Task
+	for _, t := range q.tasks {
+		if match(t) {
+			items = append(items, t)
+		}
+	}
+	return items
+}
+
+func (q *fakeQuerier) CountTasksByStatus(ctx context.Context, status string) (int64, error) {
+	return int64(len(q.all(func(t db.Task) bool { return t.Status == status }))), nil
+}
+
+func (q *fakeQuerier) CountTasksByStatusAndPriority(ctx context.Context, userID uuid.NullUUID) ([]db.CountTasksByStatusAndPriorityRow, error) {
+	type key struct{ status, priority string }
+	groups := map[key]*db.CountTasksByStatusAndPriorityRow{}
+	now := time.Now()
+	for _, t := range q.all(func(t db.Task) bool { return !userID.Valid || t.UserID == userID }) {
+		k := key{t.Status, t.Priority}
+		if groups[k] == nil {
+			groups[k] = &db.CountTasksByStatusAndPriorityRow{Status: t.Status, Priority: t.Priority}
+		}
+		groups[k].Count++
+		if t.DueDate.Valid && t.DueDate.Time.Before(now) && t.Status != "completed" && t.Status != "cancelled" {
+			groups[k].Overdue++
+		}
+	}
+	var items []db.CountTasksByStatusAndPriorityRow
+	for _, g := range groups {
+		items = append(items, *g)
+	}
+	return items, nil
+}
+
+func (q *fakeQuerier) CountTasksByUser(ctx context.Context, userID uuid.NullUUID) (int64, error) {
+	return int64(len(q.all(func(t db.Task) bool { return t.UserID == userID }))), nil
+}
+
+func (q *fakeQuerier) CreateTask(ctx context.Context, arg db.CreateTaskParams) (db.Task, error) {
+	now := time.Now()
+	t := db.Task{
+		ID:          uuid.New(),
+		Title:       arg.Title,
+		Description: arg.Description,
+		Status:      arg.Status,
+		Priority:    arg.Priority,
+		DueDate:     arg.DueDate,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		UserID:      arg.UserID,
+	}
+	q.tasks[t.ID] = t
+	return t, nil
+}
+
+func (q *fakeQuerier) DeleteTask(ctx context.Context, id uuid.UUID) (int64, error) {
+	if _, ok := q.tasks[id]; !ok {
+		return 0, nil
+	}
+	delete(q.tasks, id)
+	return 1, nil
+}
+
+func (q *fakeQuerier) GetTask(ctx context.Context, id uuid.UUID) (db.Task, error) {
+	t, ok := q.tasks[id]
+	if !ok {
+		return db.Task{}, sql.ErrNoRows
+	}
+	return t, nil
+}
+
+func (q *fakeQuerier) ListOverdueTasks(ctx context.Context, pageSize int32) ([]db.Task, error) {
+	now := time.Now()
+	return q.due(func(t db.Task) bool {
+		return t.DueDate.Time.Before(now)
+	}, pageSize), nil
+}
+
+// due returns the open tasks with a due date matching match, earliest first
+// and limited like the generated due-date queries.
+func (q *fakeQuerier) due(match func(db.Task) bool, pageSize int32) []db.Task {
+	items := q.all(func(t db.Task) bool {
+		return t.DueDate.Valid && match(t) && t.Status != "completed" && t.Status != "cancelled"
+	})
+	slices.SortFunc(items, func(a, b db.Task) int {
+		return a.DueDate.Time.Compare(b.DueDate.Time)
+	})
+	if len(items) > int(pageSize) {
+		items = items[:pageSize]
+	}
+	return items
+}
+
+// page returns the tasks matching match and the shared priority and cursor
+// arguments, ordered and limited like the generated list queries.
+func (q *fakeQuerier) page(match func(db.Task) bool, priority sql.NullString, cursorCreatedAt sql.NullTime, cursorID uuid.NullUUID, pageSize int32) []db.Task {
+	items := q.all(func(t db.Task) bool {
+		if !match(t) || (priority.Valid && t.Priority != priority.String) {
+			return false
+		}
+		if !cursorCreatedAt.Valid {
+			return true
+		}
+		return cmp.Or(t.CreatedAt.Compare(cursorCreatedAt.Time), bytes.Compare(t.ID[:], cursorID.UUID[:])) < 0
+	})
+	slices.SortFunc(items, func(a, b db.Task) int {
+		return cmp.Or(b.CreatedAt.Compare(a.CreatedAt), bytes.Compare(b.ID[:], a.ID[:]))
+	})
+	if len(items) > int(pageSize) {
+		items = items[:pageSize]
+	}
+	return items
+}
+
+func (q *fakeQuerier) ListTasks(ctx context.Context, arg db.ListTasksParams) ([]db.Task, error) {
+	return q.page(func(db.Task) bool { return true },
+		arg.Priority, arg.CursorCreatedAt, arg.CursorID, arg.PageSize), nil
+}
+
+func (q *fakeQuerier) ListTasksByStatus(ctx context.Context, arg db.ListTasksByStatusParams) ([]db.Task, error) {
+	return q.page(func(t db.Task) bool { return t.Status == arg.Status },
+		arg.Priority, arg.CursorCreatedAt, arg.CursorID, arg.PageSize), nil
+}
+
+func (q *fakeQuerier) ListTasksByUser(ctx context.Context, arg db.ListTasksByUserParams) ([]db.Task, error) {
+	return q.page(func(t db.Task) bool { return t.UserID == arg.UserID },
+		arg.Priority, arg.CursorCreatedAt, arg.CursorID, arg.PageSize), nil
+}
+
+func (q *fakeQuerier) ListTasksByUserAndStatus(ctx context.Context, arg db.ListTasksByUserAndStatusParams) ([]db.Task, error) {
+	return q.page(func(t db.Task) bool { return t.UserID == arg.UserID && t.Status == arg.Status },
+		arg.Priority, arg.CursorCreatedAt, arg.CursorID, arg.PageSize), nil
+}
+
+func (q *fakeQuerier) ListUpcomingTasks(ctx context.Context, arg db.ListUpcomingTasksParams) ([]db.Task, error) {
+	now := time.Now()
+	return q.due(func(t db.Task) bool {
+		return !t.DueDate.Time.Before(now) && !t.DueDate.Time.After(arg.DueDate.Time)
+	}, arg.PageSize), nil
+}
+
+func (q *fakeQuerier) UpdateTask(ctx context.Context, arg db.UpdateTaskParams) (db.Task, error) {
+	t, ok := q.tasks[arg.ID]
+	if !ok {
+		return db.Task{}, sql.ErrNoRows
+	}
+	if arg.Title.Valid {
+		t.Title = arg.Title.String
+	}
+	t.Description = arg.Description
+	if arg.Status.Valid {
+		t.Status = arg.Status.String
+	}
+	if arg.Priority.Valid {
+		t.Priority = arg.Priority.String
+	}
+	t.DueDate = arg.DueDate
+	t.UpdatedAt = time.Now()
+	q.tasks[t.ID] = t
+	return t, nil
+}
+
+func (q *fakeQuerier) UpdateTaskStatus(ctx context.Context, arg db.UpdateTaskStatusParams) (db.Task, error) {
+	t, ok := q.tasks[arg.ID]
+	if !ok {
+		return db.Task{}, sql.ErrNoRows
+	}
+	t.Status = arg.Status
+	t.CompletedAt = sql.NullTime{}
+	if arg.Status == "completed" {
+		t.CompletedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	}
+	t.UpdatedAt = time.Now()
+	q.tasks[t.ID] = t
+	return t, nil
+}
@@ -2,32 +2,117 @@ package task_repository
 
 import (
 	"api/src/domain/model"
+	"context"
+	"database/sql"
+	"time"
+	"utils/db/db"
 	"utils/types"
+
+	"github.com/google/uuid"
 )
 
-func FindTaskByID(id model.TaskID) types.Result[model.Task, model.AppError] {
-	return types.Ok[model.Task, model.AppError](model.Task{
-		ID:          id,
-		Title:       "Sample Task",
-		Description: "This is a sample task description.",
-		Completed:   false,
+func (r Repository) FindTaskByID(ctx context.Context, id model.TaskID) types.Result[model.Task, model.AppError] {
+	return types.Retry(ctx, r.retry, func(ctx context.Context) types.Result[model.Task, model.AppError] {
+		return types.Map(fromQuery[db.Task]("GetTask", "task_id", id.String())(r.q.GetTask(ctx, uuid.UUID(id))), toModel)
 	})
 }
 
-func FindAllTasks() types.Result[[]model.Task, model.AppError] {
-	tasks := []model.Task{
-		{
-			ID:          model.NewTaskID("00000000-0000-0000-0000-000000000001"),
-			Title:       "Sample Task 1",
-			Description: "This is the first sample task.",
-			Completed:   false,
+func (r Repository) FindTasks(ctx context.Context, filter model.TaskFilter, page model.TaskPageRequest) types.Result[model.TaskPage, model.AppError] {
+	size := page.Size()
+	// Fetch one extra row to find out whether another page follows.
+	rows := types.Retry(ctx, r.retry, func(ctx context.Context) types.Result[[]db.Task, model.AppError] {
+		return r.listTasks(ctx, filter, page.After, int32(size+1))
+	})
+	return types.Map(
+		rows,
+		func(rows []db.Task) model.TaskPage {
+			var next *model.TaskCursor
+			if len(rows) > size {
+				rows = rows[:size]
+				last := rows[size-1]
+				next = &model.TaskCursor{CreatedAt: last.CreatedAt, ID: model.TaskID(last.ID)}
+			}
+			return model.TaskPage{Tasks: toModels(rows), Next: next}
 		},
-		{
-			ID:          model.NewTaskID("00000000-0000-0000-0000-000000000002"),
-			Title:       "Sample Task 2",
-			Description: "This is the second sample task.",
-			Completed:   true,
+	)
+}
+
+func (r Repository) FindOverdueTasks(ctx context.Context, limit int) types.Result[[]model.Task, model.AppError] {
+	return types.Retry(ctx, r.retry, func(ctx context.Context) types.Result[[]model.Task, model.AppError] {
+		return types.Map(fromQuery[[]db.Task]("ListOverdueTasks")(r.q.ListOverdueTasks(ctx, int32(min(limit, model.MaxTaskPageSize)))), toModels)
+	})
+}
+
+func (r Repository) FindUpcomingTasks(ctx context.Context, until time.Time, limit int) types.Result[[]model.Task, model.AppError] {
+	return types.Retry(ctx, r.retry, func(ctx context.Context) types.Result[[]model.Task, model.AppError] {
+		return types.Map(fromQuery[[]db.Task]("ListUpcomingTasks")(r.q.ListUpcomingTasks(ctx, db.ListUpcomingTasksParams{
+			DueDate:  sql.NullTime{Time: until, Valid: true},
+			PageSize: int32(min(limit, model.MaxTaskPageSize)),
+		})), toModels)
+	})
+}
+
+func (r Repository) GetTaskStats(ctx context.Context, userID types.Option[model.UserID]) types.Result[model.TaskStats, model.AppError] {
+	rows := types.Retry(ctx, r.retry, func(ctx context.Context) types.Result[[]db.CountTasksByStatusAndPriorityRow, model.AppError] {
+		return fromQuery[[]db.CountTasksByStatusAndPriorityRow]("CountTasksByStatusAndPriority")(r.q.CountTasksByStatusAndPriority(ctx, toNullUUID(userID)))
+	})
+	return types.Map(
+		rows,
+		func(rows []db.CountTasksByStatusAndPriorityRow) model.TaskStats {
+			stats := model.NewTaskStats()
+			for _, row := range rows {
+				stats.Total += row.Count
+				stats.ByStatus[model.TaskStatus(row.Status)] += row.Count
+				stats.ByPriority[model.TaskPriority(row.Priority)] += row.Count
+				stats.Overdue += row.Overdue
+			}
+			return stats
 		},
+	)
+}
+
+// listTasks selects the most specific generated query for the status and user filters.
+func (r Repository) listTasks(ctx context.Context, filter model.TaskFilter, after *model.TaskCursor, limit int32) types.Result[[]db.Task, model.AppError] {
+	priority := toNullString(filter.Priority.String())
+	var cursorCreatedAt sql.NullTime
+	var cursorID uuid.NullUUID
+	if after != nil {
+		cursorCreatedAt = sql.NullTime{Time: after.CreatedAt, Valid: true}
+		cursorID = uuid.NullUUID{UUID: uuid.UUID(after.ID), Valid: true}
+	}
+
+	switch {
+	case filter.UserID.IsSome() && filter.Status != "":
+		return fromQuery[[]db.Task]("ListTasksByUserAndStatus")(r.q.ListTasksByUserAndStatus(ctx, db.ListTasksByUserAndStatusParams{
+			UserID:          toNullUUID(filter.UserID),
+			Status:          filter.Status.String(),
+			Priority:        priority,
+			CursorCreatedAt: cursorCreatedAt,
+			CursorID:        cursorID,
+			PageSize:        limit,
+		}))
+	case filter.UserID.IsSome():
+		return fromQuery[[]db.Task]("ListTasksByUser")(r.q.ListTasksByUser(ctx, db.ListTasksByUserParams{
+			UserID:          toNullUUID(filter.UserID),
+			Priority:        priority,
+			CursorCreatedAt: cursorCreatedAt,
+			CursorID:        cursorID,
+			PageSize:        limit,
+		}))
+	case filter.Status != "":
+		return fromQuery[[]db.Task]("ListTasksByStatus")(r.q.ListTasksByStatus(ctx, db.ListTasksByStatusParams{
+			Status:          filter.Status.String(),
+			Priority:        priority,
+			CursorCreatedAt: cursorCreatedAt,
+			CursorID:        cursorID,
+			PageSize:        limit,
+		}))
+	default:
+		return fromQuery[[]db.Task]("ListTasks")(r.q.ListTasks(ctx, db.ListTasksParams{
+			Priority:        priority,
+			CursorCreatedAt: cursorCreatedAt,
+			CursorID:        cursorID,
+			PageSize:        limit,
+		}))
 	}
-	return types.Ok[[]model.Task, model.AppError](tasks)
 }
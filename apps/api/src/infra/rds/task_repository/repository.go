@@ -0,0 +1,129 @@
+package task_repository
+
+import (
+	"api/src/domain/model"
+	"api/src/domain/repository"
+	"api/src/infra/rds"
+	"database/sql"
+	"errors"
+	"time"
+	"utils/db/db"
+	"utils/types"
+
+	"github.com/google/uuid"
+)
+
+const domainName = "TaskRepository"
+
+// taskNotFoundMessage is shown to clients in place of the driver's text.
+const taskNotFoundMessage = "task not found"
+
+// Repository provides task persistence backed by the sqlc-generated queries.
+type Repository struct {
+	q     db.Querier
+	tx    rds.TxBeginner
+	retry types.RetryPolicy[model.AppError]
+}
+
+var _ repository.TaskRepository = Repository{}
+
+// New creates a Repository that executes queries through q and starts
+// transactions for multi-step operations through tx.
+func New(q db.Querier, tx rds.TxBeginner) Repository {
+	return Repository{q: q, tx: tx, retry: readRetryPolicy}
+}
+
+// statusTxOptions serializes concurrent status changes of the same task so that
+// each transition is checked against the status it actually replaces.
+var statusTxOptions = rds.TxOptions{
+	Isolation:    sql.LevelSerializable,
+	MaxRetries:   3,
+	RetryBackoff: 10 * time.Millisecond,
+}
+
+// readRetryPolicy retries reads that failed transiently, e.g. on a dropped
+// connection, as classified by rds.IsTransient. Writes are not retried
+// because they are not idempotent.
+var readRetryPolicy = types.RetryPolicy[model.AppError]{
+	MaxAttempts:    3,
+	InitialBackoff: 20 * time.Millisecond,
+	MaxBackoff:     200 * time.Millisecond,
+	Jitter:         0.5,
+	ShouldRetry:    func(e model.AppError) bool { return rds.IsTransient(e) },
+	OnDone: func(cause error) model.AppError {
+		return model.NewContextError(cause, domainName)
+	},
+}
+
+// toModel maps a database row to the domain Task.
+func toModel(t db.Task) model.Task {
+	task := model.Task{
+		ID:          model.TaskID(t.ID),
+		Title:       model.TaskTitle(t.Title),
+		Description: types.OptionOf(model.TaskDescription(t.Description.String), t.Description.Valid),
+		Status:      model.TaskStatus(t.Status),
+		Priority:    model.TaskPriority(t.Priority),
+		DueDate:     types.OptionOf(model.TaskDueDate(t.DueDate.Time), t.DueDate.Valid),
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+		CompletedAt: types.OptionOf(t.CompletedAt.Time, t.CompletedAt.Valid),
+		UserID:      types.OptionOf(model.UserID(t.UserID.UUID), t.UserID.Valid),
+	}
+	return task
+}
+
+// toModels maps database rows to domain Tasks.
+func toModels(rows []db.Task) []model.Task {
+	return types.MapSlice(rows, toModel)
+}
+
+// toAppError translates a driver error into the corresponding AppError.
+// sql.ErrNoRows becomes a NotFoundError with a public message, everything
+// else a DatabaseError carrying the stack of the failed call. attrs, such as
+// the query name and task ID, are attached to either for logging.
+func toAppError(err error, attrs ...any) model.AppError {
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.NewNotFoundError(err, domainName,
+			model.WithPublicMessage(taskNotFoundMessage), model.WithAttrs(attrs...))
+	}
+	return model.NewDatabaseError(err, domainName, model.WithAttrs(attrs...), model.WithStack())
+}
+
+// fromQuery returns a function converting the (value, error) pair returned by
+// the generated query name into a Result. The error is translated with
+// toAppError and records name and attrs, so that
+//
+//	fromQuery[db.Task]("GetTask", "task_id", id.String())(r.q.GetTask(ctx, uuid.UUID(id)))
+//
+// logs which query failed for which task.
+func fromQuery[T any](name string, attrs ...any) func(T, error) types.Result[T, model.AppError] {
+	return func(value T, err error) types.Result[T, model.AppError] {
+		return types.MapErr(types.FromPair(value, err), func(err error) model.AppError {
+			return toAppError(err, append([]any{"query", name}, attrs...)...)
+		})
+	}
+}
+
+// toNullString converts a string to a nullable column value,
+// storing an empty string as NULL.
+func toNullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// toNullDescription converts an optional description to a nullable column value.
+func toNullDescription(d types.Option[model.TaskDescription]) sql.NullString {
+	description, ok := d.Get()
+	return sql.NullString{String: description.String(), Valid: ok}
+}
+
+// toNullTime converts an optional due date to a nullable column value.
+func toNullTime(d types.Option[model.TaskDueDate]) sql.NullTime {
+	dueDate, ok := d.Get()
+	return sql.NullTime{Time: dueDate.Time(), Valid: ok}
+}
+
+// toNullUUID converts an optional user ID to a nullable column value.
+func toNullUUID(id types.Option[model.UserID]) uuid.NullUUID {
+	userID, ok := id.Get()
+	return uuid.NullUUID{UUID: uuid.UUID(userID), Valid: ok}
+}
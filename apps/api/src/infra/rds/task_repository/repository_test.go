@@ -0,0 +1,720 @@
+package task_repository
+
+import (
+	"api/src/domain/model"
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+	"utils/db/db"
+	"utils/types"
+
+	"github.com/google/uuid"
+)
+
+// failingQuerier is a db.Querier whose calls fail with err.
+type failingQuerier struct {
+	db.Querier
+	err error
+}
+
+func (q failingQuerier) GetTask(ctx context.Context, id uuid.UUID) (db.Task, error) {
+	return db.Task{}, q.err
+}
+
+func (q failingQuerier) ListTasks(ctx context.Context, arg db.ListTasksParams) ([]db.Task, error) {
+	return nil, q.err
+}
+
+func (q failingQuerier) CreateTask(ctx context.Context, arg db.CreateTaskParams) (db.Task, error) {
+	return db.Task{}, q.err
+}
+
+func (q failingQuerier) UpdateTask(ctx context.Context, arg db.UpdateTaskParams) (db.Task, error) {
+	return db.Task{}, q.err
+}
+
+// pgError mimics a driver error carrying a SQLSTATE code.
+type pgError struct {
+	code string
+}
+
+func (e pgError) Error() string    { return "pg error " + e.code }
+func (e pgError) SQLState() string { return e.code }
+
+// flakyQuerier fails GetTask with err for the first failures calls.
+type flakyQuerier struct {
+	*fakeQuerier
+	err      error
+	failures int
+	calls    int
+}
+
+func (q *flakyQuerier) GetTask(ctx context.Context, id uuid.UUID) (db.Task, error) {
+	q.calls++
+	if q.calls <= q.failures {
+		return db.Task{}, q.err
+	}
+	return q.fakeQuerier.GetTask(ctx, id)
+}
+
+const existingTaskID = "550e8400-e29b-41d4-a716-446655440000"
+
+func TestFindTaskByID(t *testing.T) {
+	type args struct {
+		q  db.Querier
+		id string
+	}
+	type expected struct {
+		errName   string
+		publicMsg string
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "found",
+			args: args{
+				q:  newFakeQuerier(newFakeTask(existingTaskID)),
+				id: existingTaskID,
+			},
+			expected: expected{},
+		},
+		{
+			testName: "not found",
+			args: args{
+				q:  newFakeQuerier(),
+				id: existingTaskID,
+			},
+			expected: expected{
+				errName:   model.NotFoundErrorName,
+				publicMsg: taskNotFoundMessage,
+			},
+		},
+		{
+			testName: "transient failure retried",
+			args: args{
+				q:  &flakyQuerier{fakeQuerier: newFakeQuerier(newFakeTask(existingTaskID)), err: pgError{code: "08006"}, failures: 2},
+				id: existingTaskID,
+			},
+			expected: expected{},
+		},
+		{
+			testName: "permanent failure not retried",
+			args: args{
+				q:  &flakyQuerier{fakeQuerier: newFakeQuerier(newFakeTask(existingTaskID)), err: pgError{code: "42P01"}, failures: 1},
+				id: existingTaskID,
+			},
+			expected: expected{
+				errName: model.DatabaseErrorName,
+			},
+		},
+		{
+			testName: "driver failure",
+			args: args{
+				q:  failingQuerier{err: errors.New("connection refused")},
+				id: existingTaskID,
+			},
+			expected: expected{
+				errName: model.DatabaseErrorName,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			res := newTestRepository(tt.args.q).FindTaskByID(context.Background(), model.NewTaskID(tt.args.id))
+
+			res.Match(
+				func(task model.Task) {
+					if tt.expected.errName != "" {
+						t.Fatalf("expected %s, got task %v", tt.expected.errName, task.ID)
+					}
+					if task.ID.String() != tt.args.id {
+						t.Errorf("expected id %s, got %s", tt.args.id, task.ID)
+					}
+				},
+				func(e model.AppError) {
+					if e.ErrorName() != tt.expected.errName {
+						t.Errorf("expected error %q, got %q", tt.expected.errName, e.ErrorName())
+					}
+					if e.PublicMessage() != tt.expected.publicMsg {
+						t.Errorf("expected public message %q, got %q", tt.expected.publicMsg, e.PublicMessage())
+					}
+					attrs := map[string]string{}
+					for _, a := range e.Attrs() {
+						attrs[a.Key] = a.Value.String()
+					}
+					if attrs["query"] != "GetTask" || attrs["task_id"] != tt.args.id {
+						t.Errorf("expected query and task_id attrs, got %v", attrs)
+					}
+				},
+			)
+		})
+	}
+}
+
+func TestCreateTask(t *testing.T) {
+	type args struct {
+		q           db.Querier
+		title       model.TaskTitle
+		description types.Option[model.TaskDescription]
+	}
+	type expected struct {
+		errName string
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "created",
+			args: args{
+				q:           newFakeQuerier(),
+				title:       "New Task",
+				description: types.Some[model.TaskDescription]("Task Description"),
+			},
+			expected: expected{},
+		},
+		{
+			testName: "created without description",
+			args: args{
+				q:     newFakeQuerier(),
+				title: "New Task",
+			},
+			expected: expected{},
+		},
+		{
+			testName: "driver failure",
+			args: args{
+				q:     failingQuerier{err: errors.New("connection refused")},
+				title: "New Task",
+			},
+			expected: expected{
+				errName: model.DatabaseErrorName,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			res := newTestRepository(tt.args.q).CreateTask(context.Background(), model.TaskCmd{
+				Title:       tt.args.title,
+				Description: tt.args.description,
+			})
+
+			res.Match(
+				func(task model.Task) {
+					if tt.expected.errName != "" {
+						t.Fatalf("expected %s, got task %v", tt.expected.errName, task.ID)
+					}
+					if task.Title != tt.args.title || task.Description != tt.args.description {
+						t.Errorf("expected %q/%v, got %q/%v", tt.args.title, tt.args.description, task.Title, task.Description)
+					}
+					if task.Status != model.TaskStatusPending || task.Priority != model.TaskPriorityMedium {
+						t.Errorf("expected defaults pending/medium, got %s/%s", task.Status, task.Priority)
+					}
+				},
+				func(e model.AppError) {
+					if e.ErrorName() != tt.expected.errName {
+						t.Errorf("expected error %q, got %q", tt.expected.errName, e.ErrorName())
+					}
+				},
+			)
+		})
+	}
+}
+
+func TestUpdateTask(t *testing.T) {
+	dueDate := model.TaskDueDate(time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC))
+	withDueDate := newFakeTask(existingTaskID)
+	withDueDate.DueDate = sql.NullTime{Time: time.Date(2029, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+	withDescription := newFakeTask(existingTaskID)
+	withDescription.Description = sql.NullString{String: "Old description", Valid: true}
+
+	type args struct {
+		q   db.Querier
+		cmd model.TaskCmd
+	}
+	type expected struct {
+		errName string
+		dueDate types.Option[model.TaskDueDate]
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "due date replaced",
+			args: args{
+				q:   newFakeQuerier(withDueDate),
+				cmd: model.TaskCmd{Title: "Updated", DueDate: types.Some(dueDate)},
+			},
+			expected: expected{dueDate: types.Some(dueDate)},
+		},
+		{
+			testName: "missing description clears it",
+			args: args{
+				q:   newFakeQuerier(withDescription),
+				cmd: model.TaskCmd{Title: "Updated"},
+			},
+			expected: expected{dueDate: types.None[model.TaskDueDate]()},
+		},
+		{
+			testName: "missing due date clears it",
+			args: args{
+				q:   newFakeQuerier(withDueDate),
+				cmd: model.TaskCmd{Title: "Updated"},
+			},
+			expected: expected{dueDate: types.None[model.TaskDueDate]()},
+		},
+		{
+			testName: "not found",
+			args: args{
+				q:   newFakeQuerier(),
+				cmd: model.TaskCmd{Title: "Updated"},
+			},
+			expected: expected{errName: model.NotFoundErrorName},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			res := newTestRepository(tt.args.q).UpdateTask(context.Background(), model.NewTaskID(existingTaskID), tt.args.cmd)
+
+			res.Match(
+				func(task model.Task) {
+					if tt.expected.errName != "" {
+						t.Fatalf("expected %s, got task %v", tt.expected.errName, task.ID)
+					}
+					if task.DueDate != tt.expected.dueDate {
+						t.Errorf("expected due date %v, got %v", tt.expected.dueDate, task.DueDate)
+					}
+					if task.Description != tt.args.cmd.Description {
+						t.Errorf("expected description %v, got %v", tt.args.cmd.Description, task.Description)
+					}
+				},
+				func(e model.AppError) {
+					if e.ErrorName() != tt.expected.errName {
+						t.Errorf("expected error %q, got %q", tt.expected.errName, e.ErrorName())
+					}
+				},
+			)
+		})
+	}
+}
+
+func TestUpdateTaskStatus(t *testing.T) {
+	type args struct {
+		q      db.Querier
+		id     string
+		status model.TaskStatus
+		reject bool
+	}
+	type expected struct {
+		errName   string
+		completed bool
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "completed",
+			args: args{
+				q:      newFakeQuerier(newFakeTask(existingTaskID)),
+				id:     existingTaskID,
+				status: model.TaskStatusCompleted,
+			},
+			expected: expected{
+				completed: true,
+			},
+		},
+		{
+			testName: "in progress",
+			args: args{
+				q:      newFakeQuerier(newFakeTask(existingTaskID)),
+				id:     existingTaskID,
+				status: model.TaskStatusInProgress,
+			},
+			expected: expected{},
+		},
+		{
+			testName: "transition rejected",
+			args: args{
+				q:      newFakeQuerier(newFakeTask(existingTaskID)),
+				id:     existingTaskID,
+				status: model.TaskStatusCompleted,
+				reject: true,
+			},
+			expected: expected{
+				errName: model.ConflictErrorName,
+			},
+		},
+		{
+			testName: "not found",
+			args: args{
+				q:      newFakeQuerier(),
+				id:     existingTaskID,
+				status: model.TaskStatusCompleted,
+			},
+			expected: expected{
+				errName: model.NotFoundErrorName,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			repo := newTestRepository(tt.args.q)
+			transition := func(current model.TaskStatus) types.Result[model.TaskStatus, model.AppError] {
+				if tt.args.reject {
+					return types.Err[model.TaskStatus, model.AppError](model.NewConflictError(errors.New("rejected"), "test"))
+				}
+				return types.Ok[model.TaskStatus, model.AppError](tt.args.status)
+			}
+			res := repo.UpdateTaskStatus(context.Background(), model.NewTaskID(tt.args.id), transition)
+
+			res.Match(
+				func(task model.Task) {
+					if tt.expected.errName != "" {
+						t.Fatalf("expected %s, got task %v", tt.expected.errName, task.ID)
+					}
+					if task.Status != tt.args.status {
+						t.Errorf("expected status %s, got %s", tt.args.status, task.Status)
+					}
+					if task.CompletedAt.IsSome() != tt.expected.completed {
+						t.Errorf("expected completed_at set to be %v, got %v", tt.expected.completed, task.CompletedAt)
+					}
+				},
+				func(e model.AppError) {
+					if e.ErrorName() != tt.expected.errName {
+						t.Errorf("expected error %q, got %q", tt.expected.errName, e.ErrorName())
+					}
+					if tt.args.reject {
+						task := repo.FindTaskByID(context.Background(), model.NewTaskID(tt.args.id))
+						task.Match(
+							func(task model.Task) {
+								if task.Status != model.TaskStatusPending {
+									t.Errorf("expected status to stay %s, got %s", model.TaskStatusPending, task.Status)
+								}
+							},
+							func(e model.AppError) { t.Fatalf("unexpected error: %v", e) },
+						)
+					}
+				},
+			)
+		})
+	}
+}
+
+func TestDeleteTask(t *testing.T) {
+	type args struct {
+		q  db.Querier
+		id string
+	}
+	type expected struct {
+		errName string
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "deleted",
+			args: args{
+				q:  newFakeQuerier(newFakeTask(existingTaskID)),
+				id: existingTaskID,
+			},
+			expected: expected{},
+		},
+		{
+			testName: "not found",
+			args: args{
+				q:  newFakeQuerier(),
+				id: existingTaskID,
+			},
+			expected: expected{
+				errName: model.NotFoundErrorName,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			repo := newTestRepository(tt.args.q)
+			res := repo.DeleteTask(context.Background(), model.NewTaskID(tt.args.id))
+
+			res.Match(
+				func(id model.TaskID) {
+					if tt.expected.errName != "" {
+						t.Fatalf("expected %s, got deleted %v", tt.expected.errName, id)
+					}
+					if repo.FindTaskByID(context.Background(), id).IsOk() {
+						t.Errorf("expected task %v to be deleted", id)
+					}
+				},
+				func(e model.AppError) {
+					if e.ErrorName() != tt.expected.errName {
+						t.Errorf("expected error %q, got %q", tt.expected.errName, e.ErrorName())
+					}
+				},
+			)
+		})
+	}
+}
+
+func TestFindTasks(t *testing.T) {
+	const userID = "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+	owned := newFakeTask("00000000-0000-4000-8000-000000000001")
+	owned.UserID = uuid.NullUUID{UUID: uuid.MustParse(userID), Valid: true}
+	ownedDone := newFakeTask("00000000-0000-4000-8000-000000000002")
+	ownedDone.UserID = owned.UserID
+	ownedDone.Status = "completed"
+	ownedDone.Priority = "high"
+	other := newFakeTask("00000000-0000-4000-8000-000000000003")
+	q := newFakeQuerier(owned, ownedDone, other)
+
+	userFilter := model.NewUserID(userID)
+
+	type args struct {
+		filter model.TaskFilter
+	}
+	type expected struct {
+		count int
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "no filter",
+			args:     args{filter: model.TaskFilter{}},
+			expected: expected{count: 3},
+		},
+		{
+			testName: "status",
+			args:     args{filter: model.TaskFilter{Status: model.TaskStatusPending}},
+			expected: expected{count: 2},
+		},
+		{
+			testName: "user",
+			args:     args{filter: model.TaskFilter{UserID: types.Some(userFilter)}},
+			expected: expected{count: 2},
+		},
+		{
+			testName: "user and status",
+			args:     args{filter: model.TaskFilter{UserID: types.Some(userFilter), Status: model.TaskStatusCompleted}},
+			expected: expected{count: 1},
+		},
+		{
+			testName: "priority",
+			args:     args{filter: model.TaskFilter{Priority: model.TaskPriorityHigh}},
+			expected: expected{count: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			res := newTestRepository(q).FindTasks(context.Background(), tt.args.filter, model.TaskPageRequest{})
+
+			res.Match(
+				func(page model.TaskPage) {
+					if len(page.Tasks) != tt.expected.count {
+						t.Errorf("expected %d tasks, got %d", tt.expected.count, len(page.Tasks))
+					}
+				},
+				func(e model.AppError) {
+					t.Errorf("unexpected error: %v", e)
+				},
+			)
+		})
+	}
+}
+
+func TestFindTasksPagination(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var rows []db.Task
+	for i := range 5 {
+		row := newFakeTask(uuid.NewString())
+		row.CreatedAt = base.Add(time.Duration(i) * time.Hour)
+		rows = append(rows, row)
+	}
+	// Two tasks sharing a timestamp are ordered by ID.
+	rows[1].CreatedAt = rows[2].CreatedAt
+	repo := newTestRepository(newFakeQuerier(rows...))
+
+	var seen []model.TaskID
+	page := model.TaskPageRequest{Limit: 2}
+	for range 5 {
+		res := repo.FindTasks(context.Background(), model.TaskFilter{}, page)
+		if res.IsErr() {
+			t.Fatalf("unexpected error")
+		}
+		res.Match(func(p model.TaskPage) {
+			for _, task := range p.Tasks {
+				seen = append(seen, task.ID)
+			}
+			page.After = p.Next
+		}, func(model.AppError) {})
+		if page.After == nil {
+			break
+		}
+	}
+
+	if len(seen) != len(rows) {
+		t.Fatalf("expected %d tasks across pages, got %d", len(rows), len(seen))
+	}
+	slices.SortFunc(seen, func(a, b model.TaskID) int { return bytes.Compare(a[:], b[:]) })
+	if len(slices.Compact(seen)) != len(rows) {
+		t.Errorf("expected no duplicates across pages")
+	}
+}
+
+func TestFindDueTasksLimit(t *testing.T) {
+	type args struct {
+		limit int
+	}
+	type expected struct {
+		count int
+	}
+
+	var rows []db.Task
+	for i := range model.MaxTaskPageSize + 1 {
+		row := newFakeTask(uuid.NewString())
+		row.DueDate = sql.NullTime{Time: time.Now().Add(time.Duration(i+1) * time.Minute), Valid: true}
+		overdue := newFakeTask(uuid.NewString())
+		overdue.DueDate = sql.NullTime{Time: time.Now().Add(-time.Duration(i+1) * time.Minute), Valid: true}
+		rows = append(rows, row, overdue)
+	}
+	repo := newTestRepository(newFakeQuerier(rows...))
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "within the cap",
+			args:     args{limit: 3},
+			expected: expected{count: 3},
+		},
+		{
+			testName: "above the cap",
+			args:     args{limit: model.MaxTaskPageSize + 50},
+			expected: expected{count: model.MaxTaskPageSize},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			results := map[string]types.Result[[]model.Task, model.AppError]{
+				"overdue":  repo.FindOverdueTasks(context.Background(), tt.args.limit),
+				"upcoming": repo.FindUpcomingTasks(context.Background(), time.Now().Add(24*time.Hour), tt.args.limit),
+			}
+			for name, res := range results {
+				res.Match(
+					func(tasks []model.Task) {
+						if len(tasks) != tt.expected.count {
+							t.Errorf("%s: expected %d tasks, got %d", name, tt.expected.count, len(tasks))
+						}
+					},
+					func(e model.AppError) { t.Errorf("%s: unexpected error: %v", name, e) },
+				)
+			}
+		})
+	}
+}
+
+func TestGetTaskStats(t *testing.T) {
+	const userID = "7c9e6679-7425-40de-944b-e07fc1f90ae7"
+	done := newFakeTask("00000000-0000-4000-8000-000000000001")
+	done.Status = "completed"
+	done.Priority = "high"
+	overdue := newFakeTask("00000000-0000-4000-8000-000000000002")
+	overdue.DueDate = sql.NullTime{Time: time.Now().Add(-time.Hour), Valid: true}
+	overdue.UserID = uuid.NullUUID{UUID: uuid.MustParse(userID), Valid: true}
+	pending := newFakeTask("00000000-0000-4000-8000-000000000003")
+	pending.UserID = overdue.UserID
+	pendingLow := newFakeTask("00000000-0000-4000-8000-000000000004")
+	pendingLow.Priority = "low"
+	q := newFakeQuerier(done, overdue, pending, pendingLow)
+
+	owner := model.NewUserID(userID)
+
+	type args struct {
+		userID types.Option[model.UserID]
+	}
+	type expected struct {
+		total          int64
+		pending        int64
+		medium         int64
+		overdue        int64
+		completionRate float64
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "all tasks",
+			args:     args{userID: types.None[model.UserID]()},
+			expected: expected{total: 4, pending: 3, medium: 2, overdue: 1, completionRate: 0.25},
+		},
+		{
+			testName: "scoped to user",
+			args:     args{userID: types.Some(owner)},
+			expected: expected{total: 2, pending: 2, medium: 2, overdue: 1, completionRate: 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			res := newTestRepository(q).GetTaskStats(context.Background(), tt.args.userID)
+
+			res.Match(
+				func(stats model.TaskStats) {
+					if stats.Total != tt.expected.total {
+						t.Errorf("expected total %d, got %d", tt.expected.total, stats.Total)
+					}
+					if stats.ByStatus[model.TaskStatusPending] != tt.expected.pending {
+						t.Errorf("expected %d pending, got %d", tt.expected.pending, stats.ByStatus[model.TaskStatusPending])
+					}
+					if stats.ByPriority[model.TaskPriorityMedium] != tt.expected.medium {
+						t.Errorf("expected %d medium, got %d", tt.expected.medium, stats.ByPriority[model.TaskPriorityMedium])
+					}
+					if stats.Overdue != tt.expected.overdue {
+						t.Errorf("expected %d overdue, got %d", tt.expected.overdue, stats.Overdue)
+					}
+					if stats.CompletionRate() != tt.expected.completionRate {
+						t.Errorf("expected completion rate %v, got %v", tt.expected.completionRate, stats.CompletionRate())
+					}
+					if len(stats.ByStatus) != len(model.TaskStatuses) {
+						t.Errorf("expected every status to be present, got %v", stats.ByStatus)
+					}
+				},
+				func(e model.AppError) {
+					t.Errorf("unexpected error: %v", e)
+				},
+			)
+		})
+	}
+}
@@ -2,28 +2,67 @@ package task_repository
 
 import (
 	"api/src/domain/model"
+	"api/src/domain/repository"
+	"api/src/infra/rds"
+	"context"
+	"database/sql"
+	"utils/db/db"
 	"utils/types"
 
 	"github.com/google/uuid"
 )
 
-func CreateTask(title model.TaskTitle, description model.TaskDescription) types.Result[model.Task, model.AppError] {
-	newID := model.NewTaskID(uuid.New().String())
-	task := model.Task{
-		ID:          newID,
-		Title:       title,
-		Description: description,
-		Completed:   false,
+func (r Repository) CreateTask(ctx context.Context, cmd model.TaskCmd) types.Result[model.Task, model.AppError] {
+	priority := cmd.Priority
+	if priority == "" {
+		priority = model.TaskPriorityMedium
 	}
-	return types.Ok[model.Task, model.AppError](task)
+	return types.Map(fromQuery[db.Task]("CreateTask")(r.q.CreateTask(ctx, db.CreateTaskParams{
+		Title:       cmd.Title.String(),
+		Description: toNullDescription(cmd.Description),
+		Status:      model.TaskStatusPending.String(),
+		Priority:    priority.String(),
+		DueDate:     toNullTime(cmd.DueDate),
+		UserID:      toNullUUID(cmd.UserID),
+	})), toModel)
 }
 
-func UpdateTask(id model.TaskID, title model.TaskTitle, description model.TaskDescription, completed model.TaskCompleted) types.Result[model.Task, model.AppError] {
-	task := model.Task{
-		ID:          id,
-		Title:       title,
-		Description: description,
-		Completed:   completed,
-	}
-	return types.Ok[model.Task, model.AppError](task)
+func (r Repository) UpdateTask(ctx context.Context, id model.TaskID, cmd model.TaskCmd) types.Result[model.Task, model.AppError] {
+	return types.Map(fromQuery[db.Task]("UpdateTask", "task_id", id.String())(r.q.UpdateTask(ctx, db.UpdateTaskParams{
+		ID:          uuid.UUID(id),
+		Title:       toNullString(cmd.Title.String()),
+		Description: toNullDescription(cmd.Description),
+		Priority:    toNullString(cmd.Priority.String()),
+		DueDate:     toNullTime(cmd.DueDate),
+	})), toModel)
+}
+
+func (r Repository) UpdateTaskStatus(ctx context.Context, id model.TaskID, transition repository.TaskStatusTransition) types.Result[model.Task, model.AppError] {
+	return rds.RunInTx(ctx, r.tx, statusTxOptions, func(q db.Querier) types.Result[model.Task, model.AppError] {
+		return types.Pipe3(
+			fromQuery[db.Task]("GetTask", "task_id", id.String())(q.GetTask(ctx, uuid.UUID(id))),
+			func(current db.Task) types.Result[model.TaskStatus, model.AppError] {
+				return transition(model.TaskStatus(current.Status))
+			},
+			func(status model.TaskStatus) types.Result[db.Task, model.AppError] {
+				return fromQuery[db.Task]("UpdateTaskStatus", "task_id", id.String())(q.UpdateTaskStatus(ctx, db.UpdateTaskStatusParams{
+					ID:     uuid.UUID(id),
+					Status: status.String(),
+				}))
+			},
+			toModel,
+		)
+	})
+}
+
+func (r Repository) DeleteTask(ctx context.Context, id model.TaskID) types.Result[model.TaskID, model.AppError] {
+	deleted := fromQuery[int64]("DeleteTask", "task_id", id.String())(r.q.DeleteTask(ctx, uuid.UUID(id)))
+	return types.FlatMap(deleted, func(rows int64) types.Result[model.TaskID, model.AppError] {
+		// A single statement decides existence, so concurrent deletes of the
+		// same task cannot both succeed.
+		if rows == 0 {
+			return types.Err[model.TaskID](toAppError(sql.ErrNoRows, "query", "DeleteTask", "task_id", id.String()))
+		}
+		return types.Ok[model.TaskID, model.AppError](id)
+	})
 }
@@ -0,0 +1,188 @@
+package rds
+
+import (
+	"api/src/domain/model"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"time"
+	"utils/db/db"
+	"utils/types"
+)
+
+const txDomainName = "Transaction"
+
+// Tx is a database transaction exposing the generated queries.
+type Tx interface {
+	// Querier returns a Querier whose statements run inside the transaction.
+	Querier() db.Querier
+	Commit() error
+	Rollback() error
+}
+
+// TxBeginner starts transactions.
+type TxBeginner interface {
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (Tx, error)
+}
+
+// sqlTxBeginner adapts a *sql.DB to TxBeginner.
+type sqlTxBeginner struct {
+	conn *sql.DB
+}
+
+// NewTxBeginner creates a TxBeginner that starts transactions on conn.
+func NewTxBeginner(conn *sql.DB) TxBeginner {
+	return sqlTxBeginner{conn: conn}
+}
+
+func (b sqlTxBeginner) BeginTx(ctx context.Context, opts *sql.TxOptions) (Tx, error) {
+	tx, err := b.conn.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return sqlTx{tx: tx}, nil
+}
+
+// sqlTx adapts a *sql.Tx to Tx.
+type sqlTx struct {
+	tx *sql.Tx
+}
+
+func (t sqlTx) Querier() db.Querier { return db.New(t.tx) }
+func (t sqlTx) Commit() error       { return t.tx.Commit() }
+func (t sqlTx) Rollback() error     { return t.tx.Rollback() }
+
+// TxOptions configures RunInTx.
+type TxOptions struct {
+	// Isolation is the transaction isolation level. The zero value uses the driver default.
+	Isolation sql.IsolationLevel
+	// ReadOnly starts a read-only transaction.
+	ReadOnly bool
+	// MaxRetries is the number of times the whole transaction is retried after a
+	// serialization failure or deadlock. Zero disables retries.
+	MaxRetries int
+	// RetryBackoff is the delay before the first retry; it doubles on each further retry.
+	RetryBackoff time.Duration
+	// Clock waits out the backoff. Nil uses types.SystemClock.
+	Clock types.Clock
+}
+
+// RunInTx runs fn inside a transaction and commits when fn returns Ok.
+// The transaction is rolled back when fn returns Err or panics; a panic is
+// re-raised after the rollback. Serialization failures and deadlocks, whether
+// reported by fn or by the commit, restart the transaction up to opts.MaxRetries times.
+func RunInTx[T any](
+	ctx context.Context,
+	b TxBeginner,
+	opts TxOptions,
+	fn func(q db.Querier) types.Result[T, model.AppError],
+) types.Result[T, model.AppError] {
+	clock := opts.Clock
+	if clock == nil {
+		clock = types.SystemClock
+	}
+
+	backoff := opts.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		res := runOnce(ctx, b, opts, fn)
+		if res.IsOk() || attempt >= opts.MaxRetries || !isRetryable(res) {
+			return res
+		}
+		if ctx.Err() == nil {
+			select {
+			case <-ctx.Done():
+			case <-clock.After(backoff):
+				backoff *= 2
+				continue
+			}
+		}
+		return types.Err[T](model.NewContextError(ctx.Err(), txDomainName))
+	}
+}
+
+func runOnce[T any](
+	ctx context.Context,
+	b TxBeginner,
+	opts TxOptions,
+	fn func(q db.Querier) types.Result[T, model.AppError],
+) (res types.Result[T, model.AppError]) {
+	tx, err := b.BeginTx(ctx, &sql.TxOptions{Isolation: opts.Isolation, ReadOnly: opts.ReadOnly})
+	if err != nil {
+		return types.Err[T, model.AppError](model.NewDatabaseError(err, txDomainName))
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			// The original error or panic matters more than a failed rollback.
+			_ = tx.Rollback()
+		}
+	}()
+
+	res = fn(tx.Querier())
+	if res.IsErr() {
+		return res
+	}
+	if err := tx.Commit(); err != nil {
+		return types.Err[T, model.AppError](model.NewDatabaseError(err, txDomainName))
+	}
+	committed = true
+	return res
+}
+
+// sqlStateError is implemented by driver errors that carry a SQLSTATE code,
+// such as *pgconn.PgError.
+type sqlStateError interface {
+	SQLState() string
+}
+
+const (
+	sqlStateSerializationFailure = "40001"
+	sqlStateDeadlockDetected     = "40P01"
+	sqlStateAdminShutdown        = "57P01"
+	// sqlStateClassConnection is the class of connection exception codes, e.g. 08006.
+	sqlStateClassConnection = "08"
+)
+
+// sqlState returns the SQLSTATE code carried by err or any error it wraps.
+func sqlState(err error) (string, bool) {
+	var s sqlStateError
+	if !errors.As(err, &s) {
+		return "", false
+	}
+	return s.SQLState(), true
+}
+
+// IsTransient reports whether err is a database failure that may succeed if
+// the statement is run again: a broken connection, a server shutdown, a
+// serialization failure or a deadlock. Constraint violations, syntax errors
+// and other failures that would repeat are not transient.
+func IsTransient(err error) bool {
+	if errors.Is(err, driver.ErrBadConn) {
+		return true
+	}
+	code, ok := sqlState(err)
+	if !ok {
+		return false
+	}
+	switch code {
+	case sqlStateSerializationFailure, sqlStateDeadlockDetected, sqlStateAdminShutdown:
+		return true
+	}
+	return strings.HasPrefix(code, sqlStateClassConnection)
+}
+
+// isRetryable reports whether res failed because of a serialization failure or deadlock.
+func isRetryable[T any](res types.Result[T, model.AppError]) bool {
+	retryable := false
+	res.Match(
+		func(T) {},
+		func(e model.AppError) {
+			code, _ := sqlState(e)
+			retryable = code == sqlStateSerializationFailure || code == sqlStateDeadlockDetected
+		},
+	)
+	return retryable
+}
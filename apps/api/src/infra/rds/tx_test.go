@@ -0,0 +1,349 @@
+package rds
+
+import (
+	"api/src/domain/model"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+	"utils/db/db"
+	"utils/types"
+)
+
+// fakeTx records how a transaction ended.
+type fakeTx struct {
+	commitErr  error
+	committed  bool
+	rolledBack bool
+}
+
+func (tx *fakeTx) Querier() db.Querier { return nil }
+
+func (tx *fakeTx) Commit() error {
+	if tx.commitErr != nil {
+		return tx.commitErr
+	}
+	tx.committed = true
+	return nil
+}
+
+func (tx *fakeTx) Rollback() error {
+	tx.rolledBack = true
+	return nil
+}
+
+// fakeTxBeginner hands out fakeTx transactions and keeps them for inspection.
+type fakeTxBeginner struct {
+	beginErr   error
+	commitErrs []error
+	opts       []*sql.TxOptions
+	txs        []*fakeTx
+}
+
+func (b *fakeTxBeginner) BeginTx(ctx context.Context, opts *sql.TxOptions) (Tx, error) {
+	if b.beginErr != nil {
+		return nil, b.beginErr
+	}
+	tx := &fakeTx{}
+	if n := len(b.txs); n < len(b.commitErrs) {
+		tx.commitErr = b.commitErrs[n]
+	}
+	b.opts = append(b.opts, opts)
+	b.txs = append(b.txs, tx)
+	return tx, nil
+}
+
+// fakeClock records the backoff waits and ends them immediately.
+type fakeClock struct {
+	waits []time.Duration
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.waits = append(c.waits, d)
+	ch := make(chan time.Time, 1)
+	ch <- time.Time{}
+	return ch
+}
+
+// pgError mimics a driver error carrying a SQLSTATE code.
+type pgError struct {
+	code string
+}
+
+func (e pgError) Error() string    { return "pg error " + e.code }
+func (e pgError) SQLState() string { return e.code }
+
+func TestRunInTx(t *testing.T) {
+	var serializationFailure model.AppError = model.NewDatabaseError(pgError{code: sqlStateSerializationFailure}, "test")
+
+	type args struct {
+		beginErr   error
+		commitErrs []error
+		results    []types.Result[int, model.AppError]
+		maxRetries int
+	}
+	type expected struct {
+		errName    string
+		value      int
+		calls      int
+		committed  []bool
+		rolledBack []bool
+		waits      []time.Duration
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "commit on ok",
+			args: args{
+				results: []types.Result[int, model.AppError]{types.Ok[int, model.AppError](1)},
+			},
+			expected: expected{
+				value:      1,
+				calls:      1,
+				committed:  []bool{true},
+				rolledBack: []bool{false},
+			},
+		},
+		{
+			testName: "rollback on err",
+			args: args{
+				results: []types.Result[int, model.AppError]{
+					types.Err[int, model.AppError](model.NewConflictError(errors.New("conflict"), "test")),
+				},
+				maxRetries: 3,
+			},
+			expected: expected{
+				errName:    model.ConflictErrorName,
+				calls:      1,
+				committed:  []bool{false},
+				rolledBack: []bool{true},
+			},
+		},
+		{
+			testName: "retry serialization failure",
+			args: args{
+				results: []types.Result[int, model.AppError]{
+					types.Err[int, model.AppError](serializationFailure),
+					types.Ok[int, model.AppError](2),
+				},
+				maxRetries: 1,
+			},
+			expected: expected{
+				value:      2,
+				calls:      2,
+				committed:  []bool{false, true},
+				rolledBack: []bool{true, false},
+				waits:      []time.Duration{10 * time.Millisecond},
+			},
+		},
+		{
+			testName: "retry serialization failure on commit",
+			args: args{
+				commitErrs: []error{pgError{code: sqlStateSerializationFailure}},
+				results: []types.Result[int, model.AppError]{
+					types.Ok[int, model.AppError](1),
+					types.Ok[int, model.AppError](2),
+				},
+				maxRetries: 1,
+			},
+			expected: expected{
+				value:      2,
+				calls:      2,
+				committed:  []bool{false, true},
+				rolledBack: []bool{true, false},
+				waits:      []time.Duration{10 * time.Millisecond},
+			},
+		},
+		{
+			testName: "retries exhausted",
+			args: args{
+				results: []types.Result[int, model.AppError]{
+					types.Err[int, model.AppError](serializationFailure),
+					types.Err[int, model.AppError](serializationFailure),
+					types.Err[int, model.AppError](serializationFailure),
+				},
+				maxRetries: 2,
+			},
+			expected: expected{
+				errName:    model.DatabaseErrorName,
+				calls:      3,
+				committed:  []bool{false, false, false},
+				rolledBack: []bool{true, true, true},
+				waits:      []time.Duration{10 * time.Millisecond, 20 * time.Millisecond},
+			},
+		},
+		{
+			testName: "begin fails",
+			args: args{
+				beginErr: errors.New("connection refused"),
+			},
+			expected: expected{
+				errName: model.DatabaseErrorName,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			b := &fakeTxBeginner{beginErr: tt.args.beginErr, commitErrs: tt.args.commitErrs}
+			clock := &fakeClock{}
+			opts := TxOptions{
+				Isolation:    sql.LevelSerializable,
+				MaxRetries:   tt.args.maxRetries,
+				RetryBackoff: 10 * time.Millisecond,
+				Clock:        clock,
+			}
+			calls := 0
+			res := RunInTx(context.Background(), b, opts,
+				func(q db.Querier) types.Result[int, model.AppError] {
+					calls++
+					return tt.args.results[calls-1]
+				},
+			)
+
+			res.Match(
+				func(v int) {
+					if tt.expected.errName != "" {
+						t.Fatalf("expected %s, got %d", tt.expected.errName, v)
+					}
+					if v != tt.expected.value {
+						t.Errorf("expected %d, got %d", tt.expected.value, v)
+					}
+				},
+				func(e model.AppError) {
+					if e.ErrorName() != tt.expected.errName {
+						t.Errorf("expected error %q, got %q", tt.expected.errName, e.ErrorName())
+					}
+				},
+			)
+			if calls != tt.expected.calls {
+				t.Errorf("expected %d calls, got %d", tt.expected.calls, calls)
+			}
+			if !slices.Equal(clock.waits, tt.expected.waits) {
+				t.Errorf("expected waits %v, got %v", tt.expected.waits, clock.waits)
+			}
+			for i, tx := range b.txs {
+				if tx.committed != tt.expected.committed[i] {
+					t.Errorf("tx %d: expected committed %v, got %v", i, tt.expected.committed[i], tx.committed)
+				}
+				if tx.rolledBack != tt.expected.rolledBack[i] {
+					t.Errorf("tx %d: expected rolled back %v, got %v", i, tt.expected.rolledBack[i], tx.rolledBack)
+				}
+				if b.opts[i].Isolation != sql.LevelSerializable {
+					t.Errorf("tx %d: expected serializable isolation, got %v", i, b.opts[i].Isolation)
+				}
+			}
+		})
+	}
+}
+
+func TestRunInTxCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	b := &fakeTxBeginner{}
+	opts := TxOptions{MaxRetries: 3, RetryBackoff: 10 * time.Millisecond, Clock: &fakeClock{}}
+
+	res := RunInTx(ctx, b, opts, func(q db.Querier) types.Result[int, model.AppError] {
+		cancel()
+		return types.Err[int, model.AppError](model.NewDatabaseError(pgError{code: sqlStateSerializationFailure}, "test"))
+	})
+
+	res.Match(
+		func(v int) { t.Fatalf("expected error, got %d", v) },
+		func(e model.AppError) {
+			if e.ErrorName() != model.CanceledErrorName {
+				t.Errorf("expected %s, got %s", model.CanceledErrorName, e.ErrorName())
+			}
+		},
+	)
+	if len(b.txs) != 1 {
+		t.Errorf("expected no retry after cancellation, got %d transactions", len(b.txs))
+	}
+}
+
+func TestRunInTxPanic(t *testing.T) {
+	b := &fakeTxBeginner{}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic to be re-raised")
+		}
+		if !b.txs[0].rolledBack || b.txs[0].committed {
+			t.Errorf("expected rollback after panic, got committed=%v rolledBack=%v", b.txs[0].committed, b.txs[0].rolledBack)
+		}
+	}()
+
+	RunInTx(context.Background(), b, TxOptions{}, func(q db.Querier) types.Result[int, model.AppError] {
+		panic("boom")
+	})
+}
+
+func TestIsTransient(t *testing.T) {
+	type args struct {
+		err error
+	}
+	type expected struct {
+		transient bool
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "connection failure",
+			args:     args{err: pgError{code: "08006"}},
+			expected: expected{transient: true},
+		},
+		{
+			testName: "serialization failure",
+			args:     args{err: pgError{code: sqlStateSerializationFailure}},
+			expected: expected{transient: true},
+		},
+		{
+			testName: "deadlock",
+			args:     args{err: pgError{code: sqlStateDeadlockDetected}},
+			expected: expected{transient: true},
+		},
+		{
+			testName: "admin shutdown",
+			args:     args{err: pgError{code: sqlStateAdminShutdown}},
+			expected: expected{transient: true},
+		},
+		{
+			testName: "bad connection",
+			args:     args{err: fmt.Errorf("query: %w", driver.ErrBadConn)},
+			expected: expected{transient: true},
+		},
+		{
+			testName: "wrapped in an app error",
+			args:     args{err: model.NewDatabaseError(pgError{code: "08003"}, "test")},
+			expected: expected{transient: true},
+		},
+		{
+			testName: "unique violation",
+			args:     args{err: pgError{code: "23505"}},
+			expected: expected{},
+		},
+		{
+			testName: "no sqlstate",
+			args:     args{err: errors.New("connection refused")},
+			expected: expected{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			if got := IsTransient(tt.args.err); got != tt.expected.transient {
+				t.Errorf("expected transient %v, got %v", tt.expected.transient, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package response
+
+import (
+	"api/src/domain/model"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// ContentTypeProblem is the media type of RFC 9457 problem details.
+const ContentTypeProblem = "application/problem+json"
+
+// problemTypePrefix namespaces the problem type URIs, which are built from
+// the stable code of the error kind.
+const problemTypePrefix = "urn:problem-type:"
+
+// Problem is an RFC 9457 problem details object. Code, RequestID, Domain
+// and Details are extension members.
+type Problem struct {
+	Type      string               `json:"type"`
+	Title     string               `json:"title"`
+	Status    int                  `json:"status"`
+	Detail    string               `json:"detail,omitempty"`
+	Instance  string               `json:"instance,omitempty"`
+	Code      string               `json:"code"`
+	RequestID string               `json:"request_id,omitempty"`
+	Domain    string               `json:"domain,omitempty"`
+	Details   []FieldErrorResponse `json:"details,omitempty"`
+}
+
+func newProblem(r *http.Request, kind model.ErrorKind, err model.AppError) Problem {
+	body := newErrorResponse(kind, err)
+	return Problem{
+		Type:      problemTypePrefix + kind.Code,
+		Title:     statusTitle(kind.HTTPStatus),
+		Status:    kind.HTTPStatus,
+		Detail:    body.Message,
+		Instance:  r.URL.Path,
+		Code:      kind.Code,
+		RequestID: middleware.GetReqID(r.Context()),
+		Domain:    body.Domain,
+		Details:   body.Details,
+	}
+}
+
+// statusTitle returns the standard reason phrase, falling back to the
+// number for non-standard codes such as 499.
+func statusTitle(status int) string {
+	if text := http.StatusText(status); text != "" {
+		return text
+	}
+	if status == model.StatusClientClosedRequest {
+		return "Client Closed Request"
+	}
+	return strconv.Itoa(status)
+}
+
+// acceptsProblem reports whether the Accept header of r lists
+// application/problem+json with a non-zero quality that is at least as high
+// as the quality it gives application/json.
+func acceptsProblem(r *http.Request) bool {
+	problem := acceptQuality(r, ContentTypeProblem, false)
+	return problem > 0 && problem >= acceptQuality(r, "application/json", true)
+}
+
+// acceptQuality returns the quality the Accept header of r gives mediaType,
+// or 0 when the header does not list it. With wildcards, the type/* and */*
+// ranges count too; as in RFC 9110, the most specific listed range wins.
+func acceptQuality(r *http.Request, mediaType string, wildcards bool) float64 {
+	typ, _, _ := strings.Cut(mediaType, "/")
+	quality, specificity := 0.0, 0
+	for _, accept := range r.Header.Values("Accept") {
+		for _, part := range strings.Split(accept, ",") {
+			name, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+			if err != nil {
+				continue
+			}
+			var s int
+			switch {
+			case name == mediaType:
+				s = 3
+			case wildcards && name == typ+"/*":
+				s = 2
+			case wildcards && name == "*/*":
+				s = 1
+			default:
+				continue
+			}
+			q := 1.0
+			if v, err := strconv.ParseFloat(params["q"], 64); err == nil {
+				q = v
+			}
+			if s > specificity {
+				quality, specificity = q, s
+			} else if s == specificity {
+				quality = max(quality, q)
+			}
+		}
+	}
+	return quality
+}
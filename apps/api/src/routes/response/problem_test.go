@@ -0,0 +1,156 @@
+package response
+
+import (
+	"api/src/domain/model"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+func TestHandleAppErrorProblem(t *testing.T) {
+	type args struct {
+		accept string
+		err    model.AppError
+	}
+	type expected struct {
+		contentType string
+		problem     Problem
+	}
+
+	validation := model.NewValidationError(nil, "postRequest").WithDetails(
+		model.FieldError{Field: "title", Rule: "required", Message: "title is required"},
+	)
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "problem requested",
+			args: args{
+				accept: ContentTypeProblem,
+				err:    model.NewNotFoundError(nil, "TaskRepository"),
+			},
+			expected: expected{
+				contentType: ContentTypeProblem,
+				problem: Problem{
+					Type:      problemTypePrefix + "not_found",
+					Code:      "not_found",
+					Title:     "Not Found",
+					Status:    http.StatusNotFound,
+					Instance:  "/api/v1/tasks/1",
+					RequestID: "req-1",
+					Domain:    "TaskRepository",
+				},
+			},
+		},
+		{
+			testName: "problem among other types with validation details",
+			args: args{
+				accept: "application/json;q=0.5, application/problem+json",
+				err:    validation,
+			},
+			expected: expected{
+				contentType: ContentTypeProblem,
+				problem: Problem{
+					Type:      problemTypePrefix + "validation_failed",
+					Code:      "validation_failed",
+					Title:     "Bad Request",
+					Status:    http.StatusBadRequest,
+					Instance:  "/api/v1/tasks/1",
+					RequestID: "req-1",
+					Domain:    "postRequest",
+					Details:   []FieldErrorResponse{{Field: "title", Rule: "required", Message: "title is required"}},
+				},
+			},
+		},
+		{
+			testName: "problem refused",
+			args: args{
+				accept: "application/problem+json;q=0, application/json",
+				err:    model.NewNotFoundError(nil, "TaskRepository"),
+			},
+			expected: expected{
+				contentType: "application/json",
+			},
+		},
+		{
+			testName: "problem ranked below json",
+			args: args{
+				accept: "application/json, application/problem+json;q=0.1",
+				err:    model.NewNotFoundError(nil, "TaskRepository"),
+			},
+			expected: expected{
+				contentType: "application/json",
+			},
+		},
+		{
+			testName: "problem ranked above wildcard",
+			args: args{
+				accept: "application/problem+json, */*;q=0.8",
+				err:    model.NewNotFoundError(nil, "TaskRepository"),
+			},
+			expected: expected{
+				contentType: ContentTypeProblem,
+				problem: Problem{
+					Type:      problemTypePrefix + "not_found",
+					Code:      "not_found",
+					Title:     "Not Found",
+					Status:    http.StatusNotFound,
+					Instance:  "/api/v1/tasks/1",
+					RequestID: "req-1",
+					Domain:    "TaskRepository",
+				},
+			},
+		},
+		{
+			testName: "no accept header",
+			args: args{
+				err: model.NewNotFoundError(nil, "TaskRepository"),
+			},
+			expected: expected{
+				contentType: "application/json",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/tasks/1", nil)
+			req = req.WithContext(context.WithValue(req.Context(), middleware.RequestIDKey, "req-1"))
+			if tt.args.accept != "" {
+				req.Header.Set("Accept", tt.args.accept)
+			}
+
+			w := httptest.NewRecorder()
+			HandleAppError(w, req, tt.args.err)
+
+			resp := w.Result()
+			if got := resp.Header.Get("Content-Type"); got != tt.expected.contentType {
+				t.Fatalf("expected Content-Type %s, got %s", tt.expected.contentType, got)
+			}
+			if tt.expected.contentType != ContentTypeProblem {
+				return
+			}
+
+			var problem Problem
+			if err := json.NewDecoder(resp.Body).Decode(&problem); err != nil {
+				t.Fatalf("failed to decode response body: %v", err)
+			}
+			if problem.Status != resp.StatusCode {
+				t.Errorf("expected status member %d to match response %d", problem.Status, resp.StatusCode)
+			}
+			// Detail carries the error message, which is covered elsewhere.
+			problem.Detail = ""
+			if !reflect.DeepEqual(problem, tt.expected.problem) {
+				t.Errorf("expected %+v, got %+v", tt.expected.problem, problem)
+			}
+		})
+	}
+}
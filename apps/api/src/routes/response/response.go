@@ -4,6 +4,9 @@ import (
 	"api/src/domain/model"
 	"encoding/json"
 	"net/http"
+	"utils/logger"
+
+	"github.com/go-chi/chi/v5/middleware"
 )
 
 func OK(w http.ResponseWriter, body any) {
@@ -32,78 +35,80 @@ func NoContent(w http.ResponseWriter) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
-// handleAppError - AppErrorを網羅的に処理し、適切なHTTPレスポンスを返す
-func HandleAppError(w http.ResponseWriter, err model.AppError) {
-	errName := err.ErrorName()
+// handleAppError - AppErrorをエラー種別のレジストリに従って処理し、適切なHTTPレスポンスを返す
+// Acceptヘッダーでapplication/problem+jsonを受け付けるクライアントにはRFC 9457形式で返す
+func HandleAppError(w http.ResponseWriter, r *http.Request, err model.AppError) {
+	kind := model.KindOf(err)
+	logError(r, kind, err)
 
-	switch errName {
-	case model.ValidationErrorName:
-		badRequest(w, err)
-	case model.NotFoundErrorName:
-		notFound(w, err)
-	case model.UnauthorizedErrorName:
-		unauthorized(w, err)
-	case model.ForbiddenErrorName:
-		forbidden(w, err)
-	case model.BadRequestErrorName:
-		badRequest(w, err)
-	case model.ConflictErrorName:
-		conflict(w, err)
-	case model.DatabaseErrorName:
-		internalError(w, err)
-	case model.InternalServerErrorName:
-		internalError(w, err)
-	default:
-		unexpectedError(w, err)
+	contentType, body := "application/json", any(newErrorResponse(kind, err))
+	if acceptsProblem(r) {
+		contentType, body = ContentTypeProblem, newProblem(r, kind, err)
 	}
-}
-
-type ErrorResponse struct {
-	Message string `json:"message"`
-	Type    string `json:"type"`
-	Domain  string `json:"domain"`
-}
-
-func newErrorResponse(err model.AppError) ErrorResponse {
-	return ErrorResponse{
-		Message: err.Error(),
-		Type:    err.ErrorName(),
-		Domain:  err.DomainName(),
-	}
-}
-
-func writeError(w http.ResponseWriter, status int, err model.AppError) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(status)
-	if err := json.NewEncoder(w).Encode(newErrorResponse(err)); err != nil {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(kind.HTTPStatus)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 	}
 }
 
-func badRequest(w http.ResponseWriter, err model.AppError) {
-	writeError(w, http.StatusBadRequest, err)
-}
-
-func notFound(w http.ResponseWriter, err model.AppError) {
-	writeError(w, http.StatusNotFound, err)
+type ErrorResponse struct {
+	Message string               `json:"message"`
+	Code    string               `json:"code"`
+	Type    string               `json:"type"`
+	Domain  string               `json:"domain"`
+	Details []FieldErrorResponse `json:"details,omitempty"`
 }
 
-func unauthorized(w http.ResponseWriter, err model.AppError) {
-	writeError(w, http.StatusUnauthorized, err)
+// FieldErrorResponse describes one rejected input field of a ValidationError.
+type FieldErrorResponse struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
 }
 
-func internalError(w http.ResponseWriter, err model.AppError) {
-	writeError(w, http.StatusInternalServerError, err)
-}
+// internalErrorMessage replaces the text of server-side errors, which may
+// contain SQL, constraint names or host details.
+const internalErrorMessage = "An internal error occurred. Please retry later or contact support with the request ID."
 
-func forbidden(w http.ResponseWriter, err model.AppError) {
-	writeError(w, http.StatusForbidden, err)
+// publicMessage returns the message shown to clients for err: its own public
+// message if set, a generic one for server errors, and the error text otherwise.
+func publicMessage(kind model.ErrorKind, err model.AppError) string {
+	if msg := err.PublicMessage(); msg != "" {
+		return msg
+	}
+	if kind.HTTPStatus >= http.StatusInternalServerError {
+		return internalErrorMessage
+	}
+	return err.Error()
 }
 
-func conflict(w http.ResponseWriter, err model.AppError) {
-	writeError(w, http.StatusConflict, err)
+func newErrorResponse(kind model.ErrorKind, err model.AppError) ErrorResponse {
+	resp := ErrorResponse{
+		Message: publicMessage(kind, err),
+		Code:    kind.Code,
+		Type:    err.ErrorName(),
+		Domain:  err.DomainName(),
+	}
+	if ve, ok := err.(model.ValidationError); ok {
+		for _, d := range ve.Details() {
+			resp.Details = append(resp.Details, FieldErrorResponse(d))
+		}
+	}
+	return resp
 }
 
-func unexpectedError(w http.ResponseWriter, err model.AppError) {
-	writeError(w, http.StatusInternalServerError, err)
+// logError records the full error chain, which is hidden from clients for
+// server errors, at the level of its kind and keyed by the request ID that
+// the client can quote.
+func logError(r *http.Request, kind model.ErrorKind, err model.AppError) {
+	logger.Log(r.Context(), kind.LogLevel, "request failed",
+		"request_id", middleware.GetReqID(r.Context()),
+		"method", r.Method,
+		"path", r.URL.Path,
+		"status", kind.HTTPStatus,
+		"code", kind.Code,
+		"error", err,
+	)
 }
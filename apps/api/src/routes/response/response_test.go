@@ -2,9 +2,12 @@ package response
 
 import (
 	"api/src/domain/model"
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"testing"
 )
 
@@ -143,17 +146,72 @@ func TestHandleAppError(t *testing.T) {
 			expected: expected{
 				statusCode: http.StatusBadRequest,
 				body: map[string]string{
+					"code":   "bad_request",
 					"type":   model.BadRequestErrorName,
 					"domain": "TestDomain",
 				},
 			},
 		},
+		{
+			testName: "timeout",
+			args: args{
+				err: model.NewContextError(context.DeadlineExceeded, "TestDomain"),
+			},
+			expected: expected{
+				statusCode: http.StatusGatewayTimeout,
+				body: map[string]string{
+					"type":   model.TimeoutErrorName,
+					"domain": "TestDomain",
+				},
+			},
+		},
+		{
+			testName: "canceled",
+			args: args{
+				err: model.NewContextError(context.Canceled, "TestDomain"),
+			},
+			expected: expected{
+				statusCode: model.StatusClientClosedRequest,
+				body: map[string]string{
+					"type":   model.CanceledErrorName,
+					"domain": "TestDomain",
+				},
+			},
+		},
+		{
+			testName: "rate limited",
+			args: args{
+				err: model.NewRateLimitedError(nil, "TestDomain"),
+			},
+			expected: expected{
+				statusCode: http.StatusTooManyRequests,
+				body: map[string]string{
+					"code":   "rate_limited",
+					"type":   model.RateLimitedErrorName,
+					"domain": "TestDomain",
+				},
+			},
+		},
+		{
+			testName: "precondition failed",
+			args: args{
+				err: model.NewPreconditionFailedError(nil, "TestDomain"),
+			},
+			expected: expected{
+				statusCode: http.StatusPreconditionFailed,
+				body: map[string]string{
+					"code":   "precondition_failed",
+					"type":   model.PreconditionFailedErrorName,
+					"domain": "TestDomain",
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
 			w := httptest.NewRecorder()
-			HandleAppError(w, tt.args.err)
+			HandleAppError(w, httptest.NewRequest(http.MethodGet, "/tasks", nil), tt.args.err)
 
 			resp := w.Result()
 			if resp.StatusCode != tt.expected.statusCode {
@@ -177,3 +235,133 @@ func TestHandleAppError(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleAppErrorDetails(t *testing.T) {
+	type args struct {
+		err model.AppError
+	}
+	type expected struct {
+		details []FieldErrorResponse
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "validation details",
+			args: args{
+				err: model.NewValidationError(nil, "TestDomain").WithDetails(
+					model.FieldError{Field: "title", Rule: "min", Param: "3", Message: "title must be at least 3 characters long"},
+					model.FieldError{Field: "priority", Rule: "oneof", Param: "low medium high", Message: "priority must be one of: low medium high"},
+				),
+			},
+			expected: expected{
+				details: []FieldErrorResponse{
+					{Field: "title", Rule: "min", Param: "3", Message: "title must be at least 3 characters long"},
+					{Field: "priority", Rule: "oneof", Param: "low medium high", Message: "priority must be one of: low medium high"},
+				},
+			},
+		},
+		{
+			testName: "no details",
+			args: args{
+				err: model.NewNotFoundError(nil, "TestDomain"),
+			},
+			expected: expected{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			HandleAppError(w, httptest.NewRequest(http.MethodGet, "/tasks", nil), tt.args.err)
+
+			var result ErrorResponse
+			if err := json.NewDecoder(w.Result().Body).Decode(&result); err != nil {
+				t.Fatalf("failed to decode response body: %v", err)
+			}
+			if !slices.Equal(result.Details, tt.expected.details) {
+				t.Errorf("expected details %v, got %v", tt.expected.details, result.Details)
+			}
+		})
+	}
+}
+
+func TestHandleAppErrorMessage(t *testing.T) {
+	driverErr := errors.New(`pq: duplicate key value violates unique constraint "tasks_pkey" on db.internal:5432`)
+
+	type args struct {
+		err model.AppError
+	}
+	type expected struct {
+		statusCode int
+		message    string
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "database error is hidden",
+			args: args{
+				err: model.NewDatabaseError(driverErr, "TaskRepository"),
+			},
+			expected: expected{
+				statusCode: http.StatusInternalServerError,
+				message:    internalErrorMessage,
+			},
+		},
+		{
+			testName: "public message replaces internal text",
+			args: args{
+				err: model.NewInternalServerError(driverErr, "TaskRepository", model.WithPublicMessage("task storage is unavailable")),
+			},
+			expected: expected{
+				statusCode: http.StatusInternalServerError,
+				message:    "task storage is unavailable",
+			},
+		},
+		{
+			testName: "client error keeps its text",
+			args: args{
+				err: model.NewConflictError(errors.New("task already completed"), "TaskStatus"),
+			},
+			expected: expected{
+				statusCode: http.StatusConflict,
+				message:    "ConflictError [TaskStatus]: task already completed",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			for _, accept := range []string{"application/json", ContentTypeProblem} {
+				req := httptest.NewRequest(http.MethodGet, "/tasks", nil)
+				req.Header.Set("Accept", accept)
+				w := httptest.NewRecorder()
+				HandleAppError(w, req, tt.args.err)
+
+				resp := w.Result()
+				if resp.StatusCode != tt.expected.statusCode {
+					t.Errorf("expected status %v, got %v", tt.expected.statusCode, resp.StatusCode)
+				}
+
+				var result map[string]any
+				if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+					t.Fatalf("failed to decode response body: %v", err)
+				}
+				key := "message"
+				if accept == ContentTypeProblem {
+					key = "detail"
+				}
+				if result[key] != tt.expected.message {
+					t.Errorf("%s: expected %s %q, got %q", accept, key, tt.expected.message, result[key])
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package routes
 
 import (
+	"api/src/domain/repository"
 	"api/src/routes/tasks"
 	"net/http"
 
@@ -8,9 +9,16 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 )
 
-func NewRouter() http.Handler {
+// Dependencies holds the collaborators injected into the HTTP handlers.
+type Dependencies struct {
+	TaskRepository repository.TaskRepository
+}
+
+func NewRouter(deps Dependencies) http.Handler {
 	r := chi.NewRouter()
 
+	taskHandler := tasks.NewHandler(deps.TaskRepository)
+
 	// ミドルウェア
 	r.Use(middleware.RequestID)
 	r.Use(middleware.RealIP)
@@ -27,10 +35,15 @@ func NewRouter() http.Handler {
 		r.Route("/v1", func(r chi.Router) {
 			// Tasks
 			r.Route("/tasks", func(r chi.Router) {
-				r.Get("/", tasks.ListHandler)
-				r.Post("/", tasks.PostHandler)
-				r.Get("/{id}", tasks.GetHandler)
-				r.Put("/{id}", tasks.PutHandler)
+				r.Get("/", taskHandler.List)
+				r.Post("/", taskHandler.Post)
+				r.Get("/overdue", taskHandler.Overdue)
+				r.Get("/upcoming", taskHandler.Upcoming)
+				r.Get("/stats", taskHandler.Stats)
+				r.Get("/{id}", taskHandler.Get)
+				r.Put("/{id}", taskHandler.Put)
+				r.Delete("/{id}", taskHandler.Delete)
+				r.Patch("/{id}/status", taskHandler.PatchStatus)
 			})
 		})
 	})
@@ -0,0 +1,46 @@
+package tasks
+
+import (
+	"api/src/domain/model"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+	"utils/types"
+
+	"github.com/google/uuid"
+)
+
+// encodeCursor serialises a TaskCursor into an opaque URL-safe token.
+func encodeCursor(c model.TaskCursor) string {
+	raw := c.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + c.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeCursor parses a token produced by encodeCursor, returning nil when s is empty.
+func decodeCursor(s string) types.Result[*model.TaskCursor, model.AppError] {
+	if s == "" {
+		return types.Ok[*model.TaskCursor, model.AppError](nil)
+	}
+	invalid := types.Err[*model.TaskCursor, model.AppError](
+		model.NewValidationError(errors.New("invalid cursor"), "TaskCursor"),
+	)
+
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return invalid
+	}
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return invalid
+	}
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return invalid
+	}
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return invalid
+	}
+	return types.Ok[*model.TaskCursor, model.AppError](&model.TaskCursor{CreatedAt: t, ID: model.TaskID(u)})
+}
@@ -0,0 +1,74 @@
+package tasks
+
+import (
+	"api/src/domain/model"
+	"testing"
+	"time"
+)
+
+func TestDecodeCursor(t *testing.T) {
+	valid := model.TaskCursor{
+		CreatedAt: time.Date(2025, 11, 16, 11, 6, 47, 123456000, time.UTC),
+		ID:        model.NewTaskID("550e8400-e29b-41d4-a716-446655440000"),
+	}
+
+	type args struct {
+		cursor string
+	}
+	type expected struct {
+		cursor   *model.TaskCursor
+		hasError bool
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "round trip",
+			args:     args{cursor: encodeCursor(valid)},
+			expected: expected{cursor: &valid},
+		},
+		{
+			testName: "empty cursor",
+			args:     args{cursor: ""},
+			expected: expected{cursor: nil},
+		},
+		{
+			testName: "not base64",
+			args:     args{cursor: "%%%"},
+			expected: expected{hasError: true},
+		},
+		{
+			testName: "malformed payload",
+			args:     args{cursor: "bm90LWEtY3Vyc29y"},
+			expected: expected{hasError: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			result := decodeCursor(tt.args.cursor)
+
+			result.Match(
+				func(c *model.TaskCursor) {
+					if tt.expected.hasError {
+						t.Fatalf("expected error but got cursor %v", c)
+					}
+					if (c == nil) != (tt.expected.cursor == nil) {
+						t.Fatalf("expected cursor %v, got %v", tt.expected.cursor, c)
+					}
+					if c != nil && (!c.CreatedAt.Equal(tt.expected.cursor.CreatedAt) || c.ID != tt.expected.cursor.ID) {
+						t.Errorf("expected cursor %v, got %v", *tt.expected.cursor, *c)
+					}
+				},
+				func(e model.AppError) {
+					if !tt.expected.hasError {
+						t.Errorf("unexpected error: %v", e)
+					}
+				},
+			)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package tasks
+
+import (
+	"api/src/domain/model"
+	"api/src/routes/response"
+	"context"
+	"net/http"
+	"utils/types"
+)
+
+func (h Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	res := types.PipeCtx2(
+		r.Context(),
+		newDeleteRequest(r).validate(),
+		contextError,
+		func(ctx context.Context, req deleteRequest) types.Result[model.TaskID, model.AppError] {
+			return h.repo.DeleteTask(ctx, model.NewTaskID(req.ID))
+		},
+		func(id model.TaskID) model.TaskID {
+			return id
+		},
+	)
+
+	res.Match(
+		func(model.TaskID) {
+			response.NoContent(w)
+		},
+		func(e model.AppError) {
+			response.HandleAppError(w, r, e)
+		},
+	)
+}
@@ -0,0 +1,105 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestDeleteHandler(t *testing.T) {
+	type args struct {
+		id string
+	}
+	type expected struct {
+		statusCode int
+		hasError   bool
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "valid request",
+			args: args{
+				id: "550e8400-e29b-41d4-a716-446655440000",
+			},
+			expected: expected{
+				statusCode: http.StatusNoContent,
+				hasError:   false,
+			},
+		},
+		{
+			testName: "not found",
+			args: args{
+				id: "00000000-0000-4000-8000-000000000001",
+			},
+			expected: expected{
+				statusCode: http.StatusNotFound,
+				hasError:   true,
+			},
+		},
+		{
+			testName: "invalid uuid",
+			args: args{
+				id: "invalid-uuid",
+			},
+			expected: expected{
+				statusCode: http.StatusBadRequest,
+				hasError:   true,
+			},
+		},
+		{
+			testName: "missing id",
+			args: args{
+				id: "",
+			},
+			expected: expected{
+				statusCode: http.StatusBadRequest,
+				hasError:   true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, "/tasks/"+tt.args.id, nil)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.args.id)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			newTestHandler().Delete(w, req)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.expected.statusCode {
+				t.Errorf("expected status %v, got %v", tt.expected.statusCode, resp.StatusCode)
+			}
+
+			if resp.Header.Get("Content-Type") != "application/json" {
+				t.Errorf("expected Content-Type application/json, got %v", resp.Header.Get("Content-Type"))
+			}
+
+			if !tt.expected.hasError {
+				if w.Body.Len() != 0 {
+					t.Errorf("expected empty body, got %q", w.Body.String())
+				}
+				return
+			}
+
+			var result map[string]interface{}
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				t.Fatalf("failed to decode response body: %v", err)
+			}
+
+			if _, ok := result["type"]; !ok {
+				t.Errorf("expected error response to have 'type' field")
+			}
+		})
+	}
+}
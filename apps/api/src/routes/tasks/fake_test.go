@@ -0,0 +1,210 @@
+package tasks
+
+import (
+	"api/src/domain/model"
+	"api/src/domain/repository"
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+	"utils/types"
+
+	"github.com/google/uuid"
+)
+
+// fakeTaskRepository is an in-memory repository.TaskRepository used by the handler tests.
+type fakeTaskRepository struct {
+	tasks map[model.TaskID]model.Task
+}
+
+var _ repository.TaskRepository = (*fakeTaskRepository)(nil)
+
+// existingTaskID is the ID of the task always seeded by newTestHandler.
+const existingTaskID = "550e8400-e29b-41d4-a716-446655440000"
+
+// newTestHandler returns a Handler over a fake repository holding the
+// existingTaskID task plus any extra tasks.
+func newTestHandler(extra ...model.Task) Handler {
+	id := model.NewTaskID(existingTaskID)
+	repo := &fakeTaskRepository{
+		tasks: map[model.TaskID]model.Task{
+			id: {
+				ID:       id,
+				Title:    "Sample Task",
+				Status:   model.TaskStatusPending,
+				Priority: model.TaskPriorityMedium,
+			},
+		},
+	}
+	for _, task := range extra {
+		repo.tasks[task.ID] = task
+	}
+	return NewHandler(repo)
+}
+
+// newDueTask returns an open task due at the given offset from now.
+func newDueTask(offset time.Duration) model.Task {
+	return model.Task{
+		ID:       model.TaskID(uuid.New()),
+		Title:    "Due Task",
+		Status:   model.TaskStatusPending,
+		Priority: model.TaskPriorityMedium,
+		DueDate:  types.Some(model.TaskDueDate(time.Now().Add(offset))),
+	}
+}
+
+func notFound() model.AppError {
+	return model.NewNotFoundError(errors.New("task not found"), "fakeTaskRepository")
+}
+
+func (f *fakeTaskRepository) FindTaskByID(ctx context.Context, id model.TaskID) types.Result[model.Task, model.AppError] {
+	task, ok := f.tasks[id]
+	if !ok {
+		return types.Err[model.Task](notFound())
+	}
+	return types.Ok[model.Task, model.AppError](task)
+}
+
+func (f *fakeTaskRepository) FindTasks(ctx context.Context, filter model.TaskFilter, page model.TaskPageRequest) types.Result[model.TaskPage, model.AppError] {
+	tasks := make([]model.Task, 0, len(f.tasks))
+	for _, task := range f.tasks {
+		if filter.Matches(task) && (page.After == nil || isAfter(task, *page.After)) {
+			tasks = append(tasks, task)
+		}
+	}
+	slices.SortFunc(tasks, func(a, b model.Task) int {
+		return cmp.Or(b.CreatedAt.Compare(a.CreatedAt), bytes.Compare(b.ID[:], a.ID[:]))
+	})
+
+	var next *model.TaskCursor
+	if size := page.Size(); len(tasks) > size {
+		tasks = tasks[:size]
+		next = &model.TaskCursor{CreatedAt: tasks[size-1].CreatedAt, ID: tasks[size-1].ID}
+	}
+	return types.Ok[model.TaskPage, model.AppError](model.TaskPage{Tasks: tasks, Next: next})
+}
+
+// isAfter reports whether task comes after the cursor in listing order.
+func isAfter(task model.Task, c model.TaskCursor) bool {
+	return cmp.Or(task.CreatedAt.Compare(c.CreatedAt), bytes.Compare(task.ID[:], c.ID[:])) < 0
+}
+
+// findDue returns the open tasks whose due date satisfies match, earliest first.
+func (f *fakeTaskRepository) findDue(match func(time.Time) bool) []model.Task {
+	var tasks []model.Task
+	for _, task := range f.tasks {
+		open := task.Status != model.TaskStatusCompleted && task.Status != model.TaskStatusCancelled
+		if due, ok := task.DueDate.Get(); open && ok && match(due.Time()) {
+			tasks = append(tasks, task)
+		}
+	}
+	slices.SortFunc(tasks, func(a, b model.Task) int {
+		aDue, _ := a.DueDate.Get()
+		bDue, _ := b.DueDate.Get()
+		return aDue.Time().Compare(bDue.Time())
+	})
+	return tasks
+}
+
+// firstTasks returns at most limit of tasks, capped like the real repository.
+func firstTasks(tasks []model.Task, limit int) []model.Task {
+	return tasks[:min(len(tasks), limit, model.MaxTaskPageSize)]
+}
+
+func (f *fakeTaskRepository) FindOverdueTasks(ctx context.Context, limit int) types.Result[[]model.Task, model.AppError] {
+	now := time.Now()
+	return types.Ok[[]model.Task, model.AppError](firstTasks(f.findDue(func(due time.Time) bool {
+		return due.Before(now)
+	}), limit))
+}
+
+func (f *fakeTaskRepository) FindUpcomingTasks(ctx context.Context, until time.Time, limit int) types.Result[[]model.Task, model.AppError] {
+	now := time.Now()
+	return types.Ok[[]model.Task, model.AppError](firstTasks(f.findDue(func(due time.Time) bool {
+		return !due.Before(now) && !due.After(until)
+	}), limit))
+}
+
+func (f *fakeTaskRepository) GetTaskStats(ctx context.Context, userID types.Option[model.UserID]) types.Result[model.TaskStats, model.AppError] {
+	stats := model.NewTaskStats()
+	filter := model.TaskFilter{UserID: userID}
+	for _, task := range f.tasks {
+		if !filter.Matches(task) {
+			continue
+		}
+		stats.Total++
+		stats.ByStatus[task.Status]++
+		stats.ByPriority[task.Priority]++
+	}
+	for _, task := range f.findDue(func(due time.Time) bool { return due.Before(time.Now()) }) {
+		if filter.Matches(task) {
+			stats.Overdue++
+		}
+	}
+	return types.Ok[model.TaskStats, model.AppError](stats)
+}
+
+func (f *fakeTaskRepository) CreateTask(ctx context.Context, cmd model.TaskCmd) types.Result[model.Task, model.AppError] {
+	now := time.Now()
+	task := model.Task{
+		ID:          model.TaskID(uuid.New()),
+		Title:       cmd.Title,
+		Description: cmd.Description,
+		Status:      model.TaskStatusPending,
+		Priority:    cmp.Or(cmd.Priority, model.TaskPriorityMedium),
+		DueDate:     cmd.DueDate,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		UserID:      cmd.UserID,
+	}
+	f.tasks[task.ID] = task
+	return types.Ok[model.Task, model.AppError](task)
+}
+
+func (f *fakeTaskRepository) UpdateTask(ctx context.Context, id model.TaskID, cmd model.TaskCmd) types.Result[model.Task, model.AppError] {
+	task, ok := f.tasks[id]
+	if !ok {
+		return types.Err[model.Task](notFound())
+	}
+	task.Title = cmd.Title
+	task.Description = cmd.Description
+	task.Priority = cmp.Or(cmd.Priority, task.Priority)
+	if cmd.DueDate.IsSome() {
+		task.DueDate = cmd.DueDate
+	}
+	task.UpdatedAt = time.Now()
+	f.tasks[id] = task
+	return types.Ok[model.Task, model.AppError](task)
+}
+
+func (f *fakeTaskRepository) UpdateTaskStatus(ctx context.Context, id model.TaskID, transition repository.TaskStatusTransition) types.Result[model.Task, model.AppError] {
+	task, ok := f.tasks[id]
+	if !ok {
+		return types.Err[model.Task](notFound())
+	}
+	return types.Map(transition(task.Status), func(status model.TaskStatus) model.Task {
+		return f.setStatus(task, status)
+	})
+}
+
+func (f *fakeTaskRepository) setStatus(task model.Task, status model.TaskStatus) model.Task {
+	now := time.Now()
+	task.Status = status
+	task.CompletedAt = types.None[time.Time]()
+	if status == model.TaskStatusCompleted {
+		task.CompletedAt = types.Some(now)
+	}
+	task.UpdatedAt = now
+	f.tasks[task.ID] = task
+	return task
+}
+
+func (f *fakeTaskRepository) DeleteTask(ctx context.Context, id model.TaskID) types.Result[model.TaskID, model.AppError] {
+	if _, ok := f.tasks[id]; !ok {
+		return types.Err[model.TaskID](notFound())
+	}
+	delete(f.tasks, id)
+	return types.Ok[model.TaskID, model.AppError](id)
+}
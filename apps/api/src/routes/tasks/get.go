@@ -2,32 +2,24 @@ package tasks
 
 import (
 	"api/src/domain/model"
-	"api/src/infra/rds/task_repository"
 	"api/src/routes/response"
+	"context"
 	"net/http"
 	"utils/types"
 )
 
-type getResponse struct {
-	ID          string `json:"id"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Completed   bool   `json:"completed"`
-}
+type getResponse taskItem
 
-func GetHandler(w http.ResponseWriter, r *http.Request) {
-	res := types.Pipe2(
+func (h Handler) Get(w http.ResponseWriter, r *http.Request) {
+	res := types.PipeCtx2(
+		r.Context(),
 		newGetRequest(r).validate(),
-		func(req getRequest) types.Result[model.Task, model.AppError] {
-			return task_repository.FindTaskByID(model.NewTaskID(req.ID))
+		contextError,
+		func(ctx context.Context, req getRequest) types.Result[model.Task, model.AppError] {
+			return h.repo.FindTaskByID(ctx, model.NewTaskID(req.ID))
 		},
 		func(task model.Task) getResponse {
-			return getResponse{
-				ID:          task.ID.String(),
-				Title:       task.Title.String(),
-				Description: task.Description.String(),
-				Completed:   task.Completed.Bool(),
-			}
+			return getResponse(newTaskItem(task))
 		},
 	)
 
@@ -36,7 +28,7 @@ func GetHandler(w http.ResponseWriter, r *http.Request) {
 			response.OK(w, resp)
 		},
 		func(e model.AppError) {
-			response.HandleAppError(w, e)
+			response.HandleAppError(w, r, e)
 		},
 	)
 }
@@ -1,6 +1,8 @@
 package tasks
 
 import (
+	"api/src/domain/model"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -10,6 +12,7 @@ import (
 func TestGetHandler(t *testing.T) {
 	type args struct {
 		queryParams map[string]string
+		canceled    bool
 	}
 	type expected struct {
 		statusCode int
@@ -33,6 +36,31 @@ func TestGetHandler(t *testing.T) {
 				hasError:   false,
 			},
 		},
+		{
+			testName: "not found",
+			args: args{
+				queryParams: map[string]string{
+					"id": "00000000-0000-4000-8000-000000000001",
+				},
+			},
+			expected: expected{
+				statusCode: http.StatusNotFound,
+				hasError:   true,
+			},
+		},
+		{
+			testName: "canceled request",
+			args: args{
+				queryParams: map[string]string{
+					"id": "550e8400-e29b-41d4-a716-446655440000",
+				},
+				canceled: true,
+			},
+			expected: expected{
+				statusCode: model.StatusClientClosedRequest,
+				hasError:   true,
+			},
+		},
 		{
 			testName: "invalid uuid",
 			args: args{
@@ -65,9 +93,14 @@ func TestGetHandler(t *testing.T) {
 				q.Add(k, v)
 			}
 			req.URL.RawQuery = q.Encode()
+			if tt.args.canceled {
+				ctx, cancel := context.WithCancel(req.Context())
+				cancel()
+				req = req.WithContext(ctx)
+			}
 
 			w := httptest.NewRecorder()
-			GetHandler(w, req)
+			newTestHandler().Get(w, req)
 
 			resp := w.Result()
 			if resp.StatusCode != tt.expected.statusCode {
@@ -0,0 +1,23 @@
+package tasks
+
+import (
+	"api/src/domain/model"
+	"api/src/domain/repository"
+)
+
+// Handler serves the task endpoints using the injected repository.
+type Handler struct {
+	repo repository.TaskRepository
+}
+
+// NewHandler creates a Handler backed by the given TaskRepository.
+func NewHandler(repo repository.TaskRepository) Handler {
+	return Handler{repo: repo}
+}
+
+const handlerDomainName = "TaskHandler"
+
+// contextError reports a request whose context finished before its pipeline did.
+func contextError(cause error) model.AppError {
+	return model.NewContextError(cause, handlerDomainName)
+}
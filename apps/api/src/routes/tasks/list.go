@@ -2,40 +2,67 @@ package tasks
 
 import (
 	"api/src/domain/model"
-	"api/src/infra/rds/task_repository"
 	"api/src/routes/response"
+	"context"
 	"net/http"
+	"time"
 	"utils/types"
 )
 
 type listResponse struct {
-	Tasks []taskItem `json:"tasks"`
+	Tasks      []taskItem `json:"tasks"`
+	NextCursor *string    `json:"next_cursor"`
 }
 
 type taskItem struct {
-	ID          string `json:"id"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Completed   bool   `json:"completed"`
+	ID          string                  `json:"id"`
+	Title       string                  `json:"title"`
+	Description types.Option[string]    `json:"description"`
+	Status      string                  `json:"status"`
+	Priority    string                  `json:"priority"`
+	Completed   bool                    `json:"completed"`
+	DueDate     types.Option[time.Time] `json:"due_date"`
+	CreatedAt   time.Time               `json:"created_at"`
+	UpdatedAt   time.Time               `json:"updated_at"`
+	CompletedAt types.Option[time.Time] `json:"completed_at"`
+	UserID      types.Option[string]    `json:"user_id"`
 }
 
-func ListHandler(w http.ResponseWriter, r *http.Request) {
-	res := types.Pipe2(
-		newListRequest(r).validate(),
-		func(req listRequest) types.Result[[]model.Task, model.AppError] {
-			return task_repository.FindAllTasks()
+func newTaskItem(task model.Task) taskItem {
+	return taskItem{
+		ID:          task.ID.String(),
+		Title:       task.Title.String(),
+		Description: types.MapOption(task.Description, model.TaskDescription.String),
+		Status:      task.Status.String(),
+		Priority:    task.Priority.String(),
+		Completed:   task.IsCompleted(),
+		DueDate:     types.MapOption(task.DueDate, model.TaskDueDate.Time),
+		CreatedAt:   task.CreatedAt,
+		UpdatedAt:   task.UpdatedAt,
+		CompletedAt: task.CompletedAt,
+		UserID:      types.MapOption(task.UserID, model.UserID.String),
+	}
+}
+
+func newTaskItems(tasks []model.Task) []taskItem {
+	return types.MapSlice(tasks, newTaskItem)
+}
+
+func (h Handler) List(w http.ResponseWriter, r *http.Request) {
+	res := types.PipeCtx2(
+		r.Context(),
+		newListRequest(r).toQuery(),
+		contextError,
+		func(ctx context.Context, q listQuery) types.Result[model.TaskPage, model.AppError] {
+			return h.repo.FindTasks(ctx, q.filter, q.page)
 		},
-		func(tasks []model.Task) listResponse {
-			items := make([]taskItem, len(tasks))
-			for i, task := range tasks {
-				items[i] = taskItem{
-					ID:          task.ID.String(),
-					Title:       task.Title.String(),
-					Description: task.Description.String(),
-					Completed:   task.Completed.Bool(),
-				}
+		func(page model.TaskPage) listResponse {
+			resp := listResponse{Tasks: newTaskItems(page.Tasks)}
+			if page.Next != nil {
+				next := encodeCursor(*page.Next)
+				resp.NextCursor = &next
 			}
-			return listResponse{Tasks: items}
+			return resp
 		},
 	)
 
@@ -44,7 +71,7 @@ func ListHandler(w http.ResponseWriter, r *http.Request) {
 			response.OK(w, resp)
 		},
 		func(e model.AppError) {
-			response.HandleAppError(w, e)
+			response.HandleAppError(w, r, e)
 		},
 	)
 }
@@ -14,6 +14,7 @@ func TestListHandler(t *testing.T) {
 	type expected struct {
 		statusCode int
 		hasError   bool
+		count      int
 	}
 
 	tests := []struct {
@@ -22,26 +23,86 @@ func TestListHandler(t *testing.T) {
 		expected expected
 	}{
 		{
-			testName: "valid request",
+			testName: "no filters",
+			args: args{
+				queryParams: map[string]string{},
+			},
+			expected: expected{
+				statusCode: http.StatusOK,
+				hasError:   false,
+				count:      1,
+			},
+		},
+		{
+			testName: "all filters",
+			args: args{
+				queryParams: map[string]string{
+					"status":   "pending",
+					"priority": "medium",
+					"user_id":  "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+				},
+			},
+			expected: expected{
+				statusCode: http.StatusOK,
+				hasError:   false,
+				count:      0,
+			},
+		},
+		{
+			testName: "status filter",
 			args: args{
 				queryParams: map[string]string{
-					"id":          "550e8400-e29b-41d4-a716-446655440000",
-					"title":       "Test Task",
-					"description": "Test Description",
-					"completed":   "false",
+					"status": "completed",
 				},
 			},
 			expected: expected{
 				statusCode: http.StatusOK,
 				hasError:   false,
+				count:      0,
+			},
+		},
+		{
+			testName: "limit",
+			args: args{
+				queryParams: map[string]string{
+					"limit": "1",
+				},
+			},
+			expected: expected{
+				statusCode: http.StatusOK,
+				hasError:   false,
+				count:      1,
+			},
+		},
+		{
+			testName: "invalid limit",
+			args: args{
+				queryParams: map[string]string{
+					"limit": "-1",
+				},
+			},
+			expected: expected{
+				statusCode: http.StatusBadRequest,
+				hasError:   true,
+			},
+		},
+		{
+			testName: "invalid cursor",
+			args: args{
+				queryParams: map[string]string{
+					"cursor": "not-a-cursor",
+				},
+			},
+			expected: expected{
+				statusCode: http.StatusBadRequest,
+				hasError:   true,
 			},
 		},
 		{
-			testName: "invalid uuid",
+			testName: "invalid status",
 			args: args{
 				queryParams: map[string]string{
-					"id":    "invalid",
-					"title": "Test Task",
+					"status": "done",
 				},
 			},
 			expected: expected{
@@ -50,11 +111,22 @@ func TestListHandler(t *testing.T) {
 			},
 		},
 		{
-			testName: "title too short",
+			testName: "invalid priority",
 			args: args{
 				queryParams: map[string]string{
-					"id":    "00000000-0000-0000-0000-000000000001",
-					"title": "ab",
+					"priority": "critical",
+				},
+			},
+			expected: expected{
+				statusCode: http.StatusBadRequest,
+				hasError:   true,
+			},
+		},
+		{
+			testName: "invalid user id",
+			args: args{
+				queryParams: map[string]string{
+					"user_id": "invalid",
 				},
 			},
 			expected: expected{
@@ -74,7 +146,7 @@ func TestListHandler(t *testing.T) {
 			req.URL.RawQuery = q.Encode()
 
 			w := httptest.NewRecorder()
-			ListHandler(w, req)
+			newTestHandler().List(w, req)
 
 			resp := w.Result()
 			if resp.StatusCode != tt.expected.statusCode {
@@ -95,8 +167,15 @@ func TestListHandler(t *testing.T) {
 					t.Errorf("expected error response to have 'type' field")
 				}
 			} else {
-				if _, ok := result["tasks"]; !ok {
-					t.Errorf("expected success response to have 'tasks' field")
+				tasks, ok := result["tasks"].([]interface{})
+				if !ok {
+					t.Fatalf("expected success response to have 'tasks' field")
+				}
+				if len(tasks) != tt.expected.count {
+					t.Errorf("expected %d tasks, got %d", tt.expected.count, len(tasks))
+				}
+				if _, ok := result["next_cursor"]; !ok {
+					t.Errorf("expected success response to have 'next_cursor' field")
 				}
 			}
 		})
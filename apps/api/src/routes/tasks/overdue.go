@@ -0,0 +1,36 @@
+package tasks
+
+import (
+	"api/src/domain/model"
+	"api/src/routes/response"
+	"context"
+	"net/http"
+	"utils/types"
+)
+
+type overdueResponse struct {
+	Tasks []taskItem `json:"tasks"`
+}
+
+func (h Handler) Overdue(w http.ResponseWriter, r *http.Request) {
+	res := types.PipeCtx2(
+		r.Context(),
+		types.Ok[int, model.AppError](model.MaxTaskPageSize),
+		contextError,
+		func(ctx context.Context, limit int) types.Result[[]model.Task, model.AppError] {
+			return h.repo.FindOverdueTasks(ctx, limit)
+		},
+		func(tasks []model.Task) overdueResponse {
+			return overdueResponse{Tasks: newTaskItems(tasks)}
+		},
+	)
+
+	res.Match(
+		func(resp overdueResponse) {
+			response.OK(w, resp)
+		},
+		func(e model.AppError) {
+			response.HandleAppError(w, r, e)
+		},
+	)
+}
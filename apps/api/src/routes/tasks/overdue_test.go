@@ -0,0 +1,94 @@
+package tasks
+
+import (
+	"api/src/domain/model"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestOverdueHandler(t *testing.T) {
+	type args struct {
+		tasks []model.Task
+	}
+	type expected struct {
+		statusCode int
+		count      int
+	}
+
+	done := newDueTask(-time.Hour)
+	done.Status = model.TaskStatusCompleted
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "no overdue tasks",
+			args: args{
+				tasks: nil,
+			},
+			expected: expected{
+				statusCode: http.StatusOK,
+				count:      0,
+			},
+		},
+		{
+			testName: "overdue and upcoming tasks",
+			args: args{
+				tasks: []model.Task{newDueTask(-2 * time.Hour), newDueTask(-time.Hour), newDueTask(time.Hour)},
+			},
+			expected: expected{
+				statusCode: http.StatusOK,
+				count:      2,
+			},
+		},
+		{
+			testName: "completed task is not overdue",
+			args: args{
+				tasks: []model.Task{done},
+			},
+			expected: expected{
+				statusCode: http.StatusOK,
+				count:      0,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/tasks/overdue", nil)
+
+			w := httptest.NewRecorder()
+			newTestHandler(tt.args.tasks...).Overdue(w, req)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.expected.statusCode {
+				t.Errorf("expected status %v, got %v", tt.expected.statusCode, resp.StatusCode)
+			}
+
+			if resp.Header.Get("Content-Type") != "application/json" {
+				t.Errorf("expected Content-Type application/json, got %v", resp.Header.Get("Content-Type"))
+			}
+
+			var result listResponse
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				t.Fatalf("failed to decode response body: %v", err)
+			}
+
+			if len(result.Tasks) != tt.expected.count {
+				t.Errorf("expected %d tasks, got %d", tt.expected.count, len(result.Tasks))
+			}
+			for i := 1; i < len(result.Tasks); i++ {
+				prev, _ := result.Tasks[i-1].DueDate.Get()
+				due, _ := result.Tasks[i].DueDate.Get()
+				if due.Before(prev) {
+					t.Errorf("expected tasks ordered by due date")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package tasks
+
+import (
+	"api/src/domain/model"
+	"api/src/domain/service"
+	"api/src/routes/response"
+	"context"
+	"net/http"
+	"utils/types"
+)
+
+type patchStatusResponse taskItem
+
+// statusChange is a validated request to move a task to a new status.
+type statusChange struct {
+	id     model.TaskID
+	status model.TaskStatus
+}
+
+func (h Handler) PatchStatus(w http.ResponseWriter, r *http.Request) {
+	res := types.PipeCtx3(
+		r.Context(),
+		newPatchStatusRequest(r).validate(),
+		contextError,
+		func(ctx context.Context, req patchStatusRequest) types.Result[statusChange, model.AppError] {
+			return types.Map(
+				asField(model.NewTaskStatus(req.Status), "status", "oneof", enumParam(model.TaskStatuses)),
+				func(status model.TaskStatus) statusChange {
+					return statusChange{id: model.NewTaskID(req.ID), status: status}
+				},
+			)
+		},
+		func(ctx context.Context, c statusChange) types.Result[model.Task, model.AppError] {
+			return h.repo.UpdateTaskStatus(ctx, c.id, func(current model.TaskStatus) types.Result[model.TaskStatus, model.AppError] {
+				return service.TransitionTaskStatus(current, c.status)
+			})
+		},
+		func(task model.Task) patchStatusResponse {
+			return patchStatusResponse(newTaskItem(task))
+		},
+	)
+
+	res.Match(
+		func(resp patchStatusResponse) {
+			response.OK(w, resp)
+		},
+		func(e model.AppError) {
+			response.HandleAppError(w, r, e)
+		},
+	)
+}
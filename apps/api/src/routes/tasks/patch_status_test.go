@@ -0,0 +1,137 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestPatchStatusHandler(t *testing.T) {
+	type args struct {
+		id     string
+		status string
+	}
+	type expected struct {
+		statusCode int
+		hasError   bool
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "valid transition",
+			args: args{
+				id:     "550e8400-e29b-41d4-a716-446655440000",
+				status: "in_progress",
+			},
+			expected: expected{
+				statusCode: http.StatusOK,
+				hasError:   false,
+			},
+		},
+		{
+			testName: "illegal transition",
+			args: args{
+				id:     "550e8400-e29b-41d4-a716-446655440000",
+				status: "completed",
+			},
+			expected: expected{
+				statusCode: http.StatusConflict,
+				hasError:   true,
+			},
+		},
+		{
+			testName: "unknown status",
+			args: args{
+				id:     "550e8400-e29b-41d4-a716-446655440000",
+				status: "done",
+			},
+			expected: expected{
+				statusCode: http.StatusBadRequest,
+				hasError:   true,
+			},
+		},
+		{
+			testName: "missing status",
+			args: args{
+				id: "550e8400-e29b-41d4-a716-446655440000",
+			},
+			expected: expected{
+				statusCode: http.StatusBadRequest,
+				hasError:   true,
+			},
+		},
+		{
+			testName: "not found",
+			args: args{
+				id:     "00000000-0000-4000-8000-000000000001",
+				status: "in_progress",
+			},
+			expected: expected{
+				statusCode: http.StatusNotFound,
+				hasError:   true,
+			},
+		},
+		{
+			testName: "invalid uuid",
+			args: args{
+				id:     "invalid-uuid",
+				status: "in_progress",
+			},
+			expected: expected{
+				statusCode: http.StatusBadRequest,
+				hasError:   true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			formData := url.Values{}
+			if tt.args.status != "" {
+				formData.Set("status", tt.args.status)
+			}
+			req := httptest.NewRequest(http.MethodPatch, "/tasks/"+tt.args.id+"/status", strings.NewReader(formData.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.args.id)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			newTestHandler().PatchStatus(w, req)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.expected.statusCode {
+				t.Errorf("expected status %v, got %v", tt.expected.statusCode, resp.StatusCode)
+			}
+
+			if resp.Header.Get("Content-Type") != "application/json" {
+				t.Errorf("expected Content-Type application/json, got %v", resp.Header.Get("Content-Type"))
+			}
+
+			var result map[string]interface{}
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				t.Fatalf("failed to decode response body: %v", err)
+			}
+
+			if tt.expected.hasError {
+				if _, ok := result["type"]; !ok {
+					t.Errorf("expected error response to have 'type' field")
+				}
+			} else {
+				if result["status"] != tt.args.status {
+					t.Errorf("expected status %q, got %v", tt.args.status, result["status"])
+				}
+			}
+		})
+	}
+}
@@ -2,35 +2,24 @@ package tasks
 
 import (
 	"api/src/domain/model"
-	"api/src/infra/rds/task_repository"
 	"api/src/routes/response"
+	"context"
 	"net/http"
 	"utils/types"
 )
 
-type postResponse struct {
-	ID          string `json:"id"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Completed   bool   `json:"completed"`
-}
+type postResponse taskItem
 
-func PostHandler(w http.ResponseWriter, r *http.Request) {
-	res := types.Pipe2(
-		newPostRequest(r).validate(),
-		func(req postRequest) types.Result[model.Task, model.AppError] {
-			return task_repository.CreateTask(
-				model.TaskTitle(req.Title),
-				model.TaskDescription(req.Description),
-			)
+func (h Handler) Post(w http.ResponseWriter, r *http.Request) {
+	res := types.PipeCtx2(
+		r.Context(),
+		newPostRequest(r).toCmd(),
+		contextError,
+		func(ctx context.Context, cmd model.TaskCmd) types.Result[model.Task, model.AppError] {
+			return h.repo.CreateTask(ctx, cmd)
 		},
 		func(task model.Task) postResponse {
-			return postResponse{
-				ID:          task.ID.String(),
-				Title:       task.Title.String(),
-				Description: task.Description.String(),
-				Completed:   task.Completed.Bool(),
-			}
+			return postResponse(newTaskItem(task))
 		},
 	)
 
@@ -39,7 +28,7 @@ func PostHandler(w http.ResponseWriter, r *http.Request) {
 			response.Created(w, resp)
 		},
 		func(e model.AppError) {
-			response.HandleAppError(w, e)
+			response.HandleAppError(w, r, e)
 		},
 	)
 }
@@ -36,6 +36,48 @@ func TestPostHandler(t *testing.T) {
 				hasError:   false,
 			},
 		},
+		{
+			testName: "valid request with all fields",
+			args: args{
+				formData: map[string]string{
+					"title":       "New Task",
+					"description": "Task Description",
+					"priority":    "high",
+					"due_date":    "2030-01-02T15:04:05Z",
+					"user_id":     "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+				},
+			},
+			expected: expected{
+				statusCode: http.StatusCreated,
+				hasError:   false,
+			},
+		},
+		{
+			testName: "invalid priority",
+			args: args{
+				formData: map[string]string{
+					"title":    "New Task",
+					"priority": "critical",
+				},
+			},
+			expected: expected{
+				statusCode: http.StatusBadRequest,
+				hasError:   true,
+			},
+		},
+		{
+			testName: "invalid due date",
+			args: args{
+				formData: map[string]string{
+					"title":    "New Task",
+					"due_date": "tomorrow",
+				},
+			},
+			expected: expected{
+				statusCode: http.StatusBadRequest,
+				hasError:   true,
+			},
+		},
 		{
 			testName: "title too short",
 			args: args{
@@ -48,6 +90,19 @@ func TestPostHandler(t *testing.T) {
 				hasError:   true,
 			},
 		},
+		{
+			testName: "several invalid fields",
+			args: args{
+				formData: map[string]string{
+					"title":    "ab",
+					"priority": "urgent",
+				},
+			},
+			expected: expected{
+				statusCode: http.StatusBadRequest,
+				hasError:   true,
+			},
+		},
 		{
 			testName: "missing title",
 			args: args{
@@ -72,7 +127,7 @@ func TestPostHandler(t *testing.T) {
 			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
 			w := httptest.NewRecorder()
-			PostHandler(w, req)
+			newTestHandler().Post(w, req)
 
 			resp := w.Result()
 			if resp.StatusCode != tt.expected.statusCode {
@@ -92,6 +147,9 @@ func TestPostHandler(t *testing.T) {
 				if _, ok := result["type"]; !ok {
 					t.Errorf("expected error response to have 'type' field")
 				}
+				if result["message"] != validationFailedMessage {
+					t.Errorf("expected message %q, got %q", validationFailedMessage, result["message"])
+				}
 			} else {
 				if _, ok := result["id"]; !ok {
 					t.Errorf("expected success response to have 'id' field")
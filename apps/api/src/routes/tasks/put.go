@@ -2,37 +2,25 @@ package tasks
 
 import (
 	"api/src/domain/model"
-	"api/src/infra/rds/task_repository"
 	"api/src/routes/response"
+	"context"
 	"net/http"
 	"utils/types"
 )
 
-type putResponse struct {
-	ID          string `json:"id"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Completed   bool   `json:"completed"`
-}
+type putResponse taskItem
 
-func PutHandler(w http.ResponseWriter, r *http.Request) {
-	res := types.Pipe2(
-		newPutRequest(r).validate(),
-		func(req putRequest) types.Result[model.Task, model.AppError] {
-			return task_repository.UpdateTask(
-				model.NewTaskID(req.ID),
-				model.TaskTitle(req.Title),
-				model.TaskDescription(req.Description),
-				model.TaskCompleted(req.Completed),
-			)
+func (h Handler) Put(w http.ResponseWriter, r *http.Request) {
+	req := newPutRequest(r)
+	res := types.PipeCtx2(
+		r.Context(),
+		req.toCmd(),
+		contextError,
+		func(ctx context.Context, cmd model.TaskCmd) types.Result[model.Task, model.AppError] {
+			return h.repo.UpdateTask(ctx, model.NewTaskID(req.ID), cmd)
 		},
 		func(task model.Task) putResponse {
-			return putResponse{
-				ID:          task.ID.String(),
-				Title:       task.Title.String(),
-				Description: task.Description.String(),
-				Completed:   task.Completed.Bool(),
-			}
+			return putResponse(newTaskItem(task))
 		},
 	)
 
@@ -41,7 +29,7 @@ func PutHandler(w http.ResponseWriter, r *http.Request) {
 			response.OK(w, resp)
 		},
 		func(e model.AppError) {
-			response.HandleAppError(w, e)
+			response.HandleAppError(w, r, e)
 		},
 	)
 }
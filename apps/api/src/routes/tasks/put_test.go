@@ -30,7 +30,7 @@ func TestPutHandler(t *testing.T) {
 					"id":          "550e8400-e29b-41d4-a716-446655440000",
 					"title":       "Updated Task",
 					"description": "Updated Description",
-					"completed":   "true",
+					"priority":    "low",
 				},
 			},
 			expected: expected{
@@ -38,6 +38,33 @@ func TestPutHandler(t *testing.T) {
 				hasError:   false,
 			},
 		},
+		{
+			testName: "invalid priority",
+			args: args{
+				formData: map[string]string{
+					"id":       "550e8400-e29b-41d4-a716-446655440000",
+					"title":    "Updated Task",
+					"priority": "critical",
+				},
+			},
+			expected: expected{
+				statusCode: http.StatusBadRequest,
+				hasError:   true,
+			},
+		},
+		{
+			testName: "not found",
+			args: args{
+				formData: map[string]string{
+					"id":    "00000000-0000-4000-8000-000000000001",
+					"title": "Updated Task",
+				},
+			},
+			expected: expected{
+				statusCode: http.StatusNotFound,
+				hasError:   true,
+			},
+		},
 		{
 			testName: "invalid uuid",
 			args: args{
@@ -88,7 +115,7 @@ func TestPutHandler(t *testing.T) {
 			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
 			w := httptest.NewRecorder()
-			PutHandler(w, req)
+			newTestHandler().Put(w, req)
 
 			resp := w.Result()
 			if resp.StatusCode != tt.expected.statusCode {
@@ -2,10 +2,13 @@ package tasks
 
 import (
 	"api/src/domain/model"
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 	"utils/types"
 
-	"github.com/go-playground/validator/v10"
+	"github.com/go-chi/chi/v5"
 	"github.com/microcosm-cc/bluemonday"
 )
 
@@ -20,54 +23,157 @@ func newGetRequest(r *http.Request) getRequest {
 }
 
 func (r getRequest) validate() types.Result[getRequest, model.AppError] {
-	validate := validator.New()
 	if err := validate.Struct(r); err != nil {
 		return types.Err[getRequest, model.AppError](
-			model.NewValidationError(err, "GetRequest"),
+			newValidationError(err, "GetRequest"),
 		)
 	}
 	return types.Ok[getRequest, model.AppError](r)
 }
 
 type listRequest struct {
-	ID          string `json:"id" validate:"required,uuid4"`
-	Title       string `json:"title" validate:"required,min=3,max=100"`
-	Description string `json:"description" validate:"max=500"`
-	Completed   bool   `json:"completed"`
+	Status   string `json:"status"`
+	Priority string `json:"priority"`
+	UserID   string `json:"user_id" validate:"omitempty,uuid4"`
+	Limit    string `json:"limit"`
+	Cursor   string `json:"cursor"`
 }
 
 func newListRequest(r *http.Request) listRequest {
 	return listRequest{
-		ID:          r.URL.Query().Get("id"),
-		Title:       r.URL.Query().Get("title"),
-		Description: r.URL.Query().Get("description"),
-		Completed:   r.URL.Query().Get("completed") == "true",
+		Status:   r.URL.Query().Get("status"),
+		Priority: r.URL.Query().Get("priority"),
+		UserID:   r.URL.Query().Get("user_id"),
+		Limit:    r.URL.Query().Get("limit"),
+		Cursor:   r.URL.Query().Get("cursor"),
 	}
 }
 
 func (r listRequest) validate() types.Result[listRequest, model.AppError] {
-	sanitize := bluemonday.StrictPolicy()
-	r.Title = sanitize.Sanitize(r.Title)
-	r.Description = sanitize.Sanitize(r.Description)
-
-	validate := validator.New()
 	if err := validate.Struct(r); err != nil {
 		return types.Err[listRequest, model.AppError](
-			model.NewValidationError(err, "listRequest"),
+			newValidationError(err, "listRequest"),
 		)
 	}
 	return types.Ok[listRequest, model.AppError](r)
 }
 
+// listQuery is a validated list request.
+type listQuery struct {
+	filter model.TaskFilter
+	page   model.TaskPageRequest
+}
+
+// toQuery validates the request and parses its filter and page together,
+// reporting every invalid parameter at once.
+func (r listRequest) toQuery() types.Result[listQuery, model.AppError] {
+	return collectValidation(
+		types.Zip3(
+			types.ToValidation(r.validate()),
+			r.toFilter(),
+			r.toPage(),
+			func(req listRequest, filter model.TaskFilter, page model.TaskPageRequest) listQuery {
+				// UserID is parsed only once validate has accepted it.
+				filter.UserID = parseUserID(req.UserID)
+				return listQuery{filter: filter, page: page}
+			},
+		),
+		"listRequest",
+	)
+}
+
+func (r listRequest) toFilter() types.Validation[model.TaskFilter, model.AppError] {
+	return types.Zip2(
+		types.ToValidation(asField(parseOptional(r.Status, model.NewTaskStatus), "status", "oneof", enumParam(model.TaskStatuses))),
+		types.ToValidation(asField(parseOptional(r.Priority, model.NewTaskPriority), "priority", "oneof", enumParam(model.TaskPriorities))),
+		func(status model.TaskStatus, priority model.TaskPriority) model.TaskFilter {
+			return model.TaskFilter{Status: status, Priority: priority}
+		},
+	)
+}
+
+func (r listRequest) toPage() types.Validation[model.TaskPageRequest, model.AppError] {
+	return types.Zip2(
+		types.ToValidation(parseLimit(r.Limit)),
+		types.ToValidation(asField(decodeCursor(r.Cursor), "cursor", "cursor", "")),
+		func(limit int, after *model.TaskCursor) model.TaskPageRequest {
+			return model.TaskPageRequest{Limit: limit, After: after}
+		},
+	)
+}
+
+const (
+	// defaultUpcomingWindow is used when an upcoming request has no window.
+	defaultUpcomingWindow = 24 * time.Hour
+	// maxUpcomingWindow bounds how far ahead upcoming tasks can be listed.
+	maxUpcomingWindow = 90 * 24 * time.Hour
+)
+
+type upcomingRequest struct {
+	Within string `json:"within"`
+}
+
+func newUpcomingRequest(r *http.Request) upcomingRequest {
+	return upcomingRequest{
+		Within: r.URL.Query().Get("within"),
+	}
+}
+
+func (r upcomingRequest) toWindow() types.Result[time.Duration, model.AppError] {
+	if r.Within == "" {
+		return types.Ok[time.Duration, model.AppError](defaultUpcomingWindow)
+	}
+	within, err := time.ParseDuration(r.Within)
+	if err != nil {
+		return types.Err[time.Duration, model.AppError](
+			newFieldError(err, "upcomingRequest", "within", "duration", ""),
+		)
+	}
+	if within <= 0 || within > maxUpcomingWindow {
+		return types.Err[time.Duration, model.AppError](
+			newFieldError(
+				fmt.Errorf("within must be between 0 and %s, got %s", maxUpcomingWindow, within),
+				"upcomingRequest", "within", "range", fmt.Sprintf("(0, %s]", maxUpcomingWindow),
+			),
+		)
+	}
+	return types.Ok[time.Duration, model.AppError](within)
+}
+
+type statsRequest struct {
+	UserID string `json:"user_id" validate:"omitempty,uuid4"`
+}
+
+func newStatsRequest(r *http.Request) statsRequest {
+	return statsRequest{
+		UserID: r.URL.Query().Get("user_id"),
+	}
+}
+
+func (r statsRequest) validate() types.Result[statsRequest, model.AppError] {
+	if err := validate.Struct(r); err != nil {
+		return types.Err[statsRequest, model.AppError](
+			newValidationError(err, "statsRequest"),
+		)
+	}
+	return types.Ok[statsRequest, model.AppError](r)
+}
+
 type postRequest struct {
 	Title       string `json:"title" validate:"required,min=3,max=100"`
 	Description string `json:"description" validate:"max=500"`
+	Priority    string `json:"priority"`
+	DueDate     string `json:"due_date"`
+	UserID      string `json:"user_id" validate:"omitempty,uuid4"`
 }
 
 func newPostRequest(r *http.Request) postRequest {
 	return postRequest{
 		Title:       r.FormValue("title"),
 		Description: r.FormValue("description"),
+		Priority:    r.FormValue("priority"),
+		DueDate:     r.FormValue("due_date"),
+		UserID:      r.FormValue("user_id"),
 	}
 }
 
@@ -76,20 +182,42 @@ func (r postRequest) validate() types.Result[postRequest, model.AppError] {
 	r.Title = sanitize.Sanitize(r.Title)
 	r.Description = sanitize.Sanitize(r.Description)
 
-	validate := validator.New()
 	if err := validate.Struct(r); err != nil {
 		return types.Err[postRequest, model.AppError](
-			model.NewValidationError(err, "postRequest"),
+			newValidationError(err, "postRequest"),
 		)
 	}
 	return types.Ok[postRequest, model.AppError](r)
 }
 
+// toCmd validates the request and parses its fields together,
+// reporting every invalid field at once.
+func (r postRequest) toCmd() types.Result[model.TaskCmd, model.AppError] {
+	return collectValidation(
+		types.Zip3(
+			types.ToValidation(r.validate()),
+			types.ToValidation(asField(parseOptional(r.Priority, model.NewTaskPriority), "priority", "oneof", enumParam(model.TaskPriorities))),
+			types.ToValidation(parseDueDate(r.DueDate)),
+			func(req postRequest, priority model.TaskPriority, dueDate types.Option[model.TaskDueDate]) model.TaskCmd {
+				return model.TaskCmd{
+					Title:       model.TaskTitle(req.Title),
+					Description: parseDescription(req.Description),
+					Priority:    priority,
+					DueDate:     dueDate,
+					UserID:      parseUserID(req.UserID),
+				}
+			},
+		),
+		"postRequest",
+	)
+}
+
 type putRequest struct {
 	ID          string `json:"id" validate:"required,uuid4"`
 	Title       string `json:"title" validate:"required,min=3,max=100"`
 	Description string `json:"description" validate:"max=500"`
-	Completed   bool   `json:"completed"`
+	Priority    string `json:"priority"`
+	DueDate     string `json:"due_date"`
 }
 
 func newPutRequest(r *http.Request) putRequest {
@@ -97,7 +225,8 @@ func newPutRequest(r *http.Request) putRequest {
 		ID:          r.FormValue("id"),
 		Title:       r.FormValue("title"),
 		Description: r.FormValue("description"),
-		Completed:   r.FormValue("completed") == "true",
+		Priority:    r.FormValue("priority"),
+		DueDate:     r.FormValue("due_date"),
 	}
 }
 
@@ -106,11 +235,124 @@ func (r putRequest) validate() types.Result[putRequest, model.AppError] {
 	r.Title = sanitize.Sanitize(r.Title)
 	r.Description = sanitize.Sanitize(r.Description)
 
-	validate := validator.New()
 	if err := validate.Struct(r); err != nil {
 		return types.Err[putRequest, model.AppError](
-			model.NewValidationError(err, "putRequest"),
+			newValidationError(err, "putRequest"),
 		)
 	}
 	return types.Ok[putRequest, model.AppError](r)
 }
+
+// toCmd validates the request and parses its fields together,
+// reporting every invalid field at once.
+func (r putRequest) toCmd() types.Result[model.TaskCmd, model.AppError] {
+	return collectValidation(
+		types.Zip3(
+			types.ToValidation(r.validate()),
+			types.ToValidation(asField(parseOptional(r.Priority, model.NewTaskPriority), "priority", "oneof", enumParam(model.TaskPriorities))),
+			types.ToValidation(parseDueDate(r.DueDate)),
+			func(req putRequest, priority model.TaskPriority, dueDate types.Option[model.TaskDueDate]) model.TaskCmd {
+				return model.TaskCmd{
+					Title:       model.TaskTitle(req.Title),
+					Description: parseDescription(req.Description),
+					Priority:    priority,
+					DueDate:     dueDate,
+				}
+			},
+		),
+		"putRequest",
+	)
+}
+
+type patchStatusRequest struct {
+	ID     string `json:"id" validate:"required,uuid4"`
+	Status string `json:"status" validate:"required"`
+}
+
+func newPatchStatusRequest(r *http.Request) patchStatusRequest {
+	return patchStatusRequest{
+		ID:     chi.URLParam(r, "id"),
+		Status: r.FormValue("status"),
+	}
+}
+
+func (r patchStatusRequest) validate() types.Result[patchStatusRequest, model.AppError] {
+	if err := validate.Struct(r); err != nil {
+		return types.Err[patchStatusRequest, model.AppError](
+			newValidationError(err, "patchStatusRequest"),
+		)
+	}
+	return types.Ok[patchStatusRequest, model.AppError](r)
+}
+
+type deleteRequest struct {
+	ID string `json:"id" validate:"required,uuid4"`
+}
+
+func newDeleteRequest(r *http.Request) deleteRequest {
+	return deleteRequest{
+		ID: chi.URLParam(r, "id"),
+	}
+}
+
+func (r deleteRequest) validate() types.Result[deleteRequest, model.AppError] {
+	if err := validate.Struct(r); err != nil {
+		return types.Err[deleteRequest, model.AppError](
+			newValidationError(err, "deleteRequest"),
+		)
+	}
+	return types.Ok[deleteRequest, model.AppError](r)
+}
+
+// parseOptional parses s with parse, or returns the zero value when s is empty.
+func parseOptional[T any](s string, parse func(string) types.Result[T, model.AppError]) types.Result[T, model.AppError] {
+	if s == "" {
+		var zero T
+		return types.Ok[T, model.AppError](zero)
+	}
+	return parse(s)
+}
+
+// parseLimit parses the page size, returning zero when s is empty.
+func parseLimit(s string) types.Result[int, model.AppError] {
+	if s == "" {
+		return types.Ok[int, model.AppError](0)
+	}
+	n, err := strconv.Atoi(s)
+	if err == nil && n < 0 {
+		err = fmt.Errorf("negative limit %d", n)
+	}
+	if err != nil {
+		return types.Err[int, model.AppError](newFieldError(err, "listRequest", "limit", "number", ""))
+	}
+	return types.Ok[int, model.AppError](n)
+}
+
+// parseDueDate parses an RFC 3339 due date, returning None when s is empty.
+func parseDueDate(s string) types.Result[types.Option[model.TaskDueDate], model.AppError] {
+	if s == "" {
+		return types.Ok[types.Option[model.TaskDueDate], model.AppError](types.None[model.TaskDueDate]())
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return types.Err[types.Option[model.TaskDueDate], model.AppError](
+			newFieldError(err, "TaskDueDate", "due_date", "datetime", "RFC3339"),
+		)
+	}
+	return types.Ok[types.Option[model.TaskDueDate], model.AppError](types.Some(model.TaskDueDate(t)))
+}
+
+// parseDescription converts an already sanitized description to an Option,
+// returning None when s is empty.
+func parseDescription(s string) types.Option[model.TaskDescription] {
+	return types.OptionOf(model.TaskDescription(s), s != "")
+}
+
+// parseUserID converts an already validated UUID string to a UserID,
+// returning None when s is empty.
+func parseUserID(s string) types.Option[model.UserID] {
+	if s == "" {
+		return types.None[model.UserID]()
+	}
+	return types.Some(model.NewUserID(s))
+}
@@ -1,6 +1,8 @@
 package tasks
 
 import (
+	"api/src/domain/model"
+	"slices"
 	"testing"
 )
 
@@ -124,3 +126,78 @@ func TestPostRequestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestPostRequestToCmd(t *testing.T) {
+	type args struct {
+		req postRequest
+	}
+	type expected struct {
+		errName string
+		fields  []string
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "valid request",
+			args: args{
+				req: postRequest{Title: "Valid Task Title", Priority: "high", DueDate: "2030-01-02T15:04:05Z"},
+			},
+			expected: expected{},
+		},
+		{
+			testName: "single invalid field",
+			args: args{
+				req: postRequest{Title: "Valid Task Title", Priority: "urgent"},
+			},
+			expected: expected{
+				errName: model.ValidationErrorName,
+				fields:  []string{"priority"},
+			},
+		},
+		{
+			testName: "every invalid field reported",
+			args: args{
+				req: postRequest{Title: "ab", Priority: "urgent", DueDate: "tomorrow"},
+			},
+			expected: expected{
+				errName: model.ValidationErrorName,
+				fields:  []string{"title", "priority", "due_date"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			tt.args.req.toCmd().Match(
+				func(cmd model.TaskCmd) {
+					if tt.expected.errName != "" {
+						t.Fatalf("expected %s, got %+v", tt.expected.errName, cmd)
+					}
+				},
+				func(e model.AppError) {
+					if e.ErrorName() != tt.expected.errName {
+						t.Errorf("expected error %q, got %q", tt.expected.errName, e.ErrorName())
+					}
+					ve, ok := e.(model.ValidationError)
+					if !ok {
+						t.Fatalf("expected model.ValidationError, got %T", e)
+					}
+					var fields []string
+					for _, d := range ve.Details() {
+						fields = append(fields, d.Field)
+						if d.Message == "" {
+							t.Errorf("expected a message for field %q", d.Field)
+						}
+					}
+					if !slices.Equal(fields, tt.expected.fields) {
+						t.Errorf("expected fields %v, got %v", tt.expected.fields, fields)
+					}
+				},
+			)
+		})
+	}
+}
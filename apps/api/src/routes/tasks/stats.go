@@ -0,0 +1,53 @@
+package tasks
+
+import (
+	"api/src/domain/model"
+	"api/src/routes/response"
+	"context"
+	"net/http"
+	"utils/types"
+)
+
+type statsResponse struct {
+	Total          int64            `json:"total"`
+	ByStatus       map[string]int64 `json:"by_status"`
+	ByPriority     map[string]int64 `json:"by_priority"`
+	Overdue        int64            `json:"overdue"`
+	CompletionRate float64          `json:"completion_rate"`
+}
+
+func (h Handler) Stats(w http.ResponseWriter, r *http.Request) {
+	res := types.PipeCtx2(
+		r.Context(),
+		newStatsRequest(r).validate(),
+		contextError,
+		func(ctx context.Context, req statsRequest) types.Result[model.TaskStats, model.AppError] {
+			return h.repo.GetTaskStats(ctx, parseUserID(req.UserID))
+		},
+		func(stats model.TaskStats) statsResponse {
+			resp := statsResponse{
+				Total:          stats.Total,
+				ByStatus:       make(map[string]int64, len(stats.ByStatus)),
+				ByPriority:     make(map[string]int64, len(stats.ByPriority)),
+				Overdue:        stats.Overdue,
+				CompletionRate: stats.CompletionRate(),
+			}
+			for status, count := range stats.ByStatus {
+				resp.ByStatus[status.String()] = count
+			}
+			for priority, count := range stats.ByPriority {
+				resp.ByPriority[priority.String()] = count
+			}
+			return resp
+		},
+	)
+
+	res.Match(
+		func(resp statsResponse) {
+			response.OK(w, resp)
+		},
+		func(e model.AppError) {
+			response.HandleAppError(w, r, e)
+		},
+	)
+}
@@ -0,0 +1,112 @@
+package tasks
+
+import (
+	"api/src/domain/model"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestStatsHandler(t *testing.T) {
+	type args struct {
+		queryParams map[string]string
+	}
+	type expected struct {
+		statusCode int
+		hasError   bool
+		total      float64
+	}
+
+	done := newDueTask(-time.Hour)
+	done.Status = model.TaskStatusCompleted
+	tasks := []model.Task{done, newDueTask(-time.Hour)}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "all tasks",
+			args: args{
+				queryParams: map[string]string{},
+			},
+			expected: expected{
+				statusCode: http.StatusOK,
+				hasError:   false,
+				total:      3,
+			},
+		},
+		{
+			testName: "scoped to user",
+			args: args{
+				queryParams: map[string]string{
+					"user_id": "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+				},
+			},
+			expected: expected{
+				statusCode: http.StatusOK,
+				hasError:   false,
+				total:      0,
+			},
+		},
+		{
+			testName: "invalid user id",
+			args: args{
+				queryParams: map[string]string{
+					"user_id": "invalid",
+				},
+			},
+			expected: expected{
+				statusCode: http.StatusBadRequest,
+				hasError:   true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/tasks/stats", nil)
+			q := req.URL.Query()
+			for k, v := range tt.args.queryParams {
+				q.Add(k, v)
+			}
+			req.URL.RawQuery = q.Encode()
+
+			w := httptest.NewRecorder()
+			newTestHandler(tasks...).Stats(w, req)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.expected.statusCode {
+				t.Errorf("expected status %v, got %v", tt.expected.statusCode, resp.StatusCode)
+			}
+
+			if resp.Header.Get("Content-Type") != "application/json" {
+				t.Errorf("expected Content-Type application/json, got %v", resp.Header.Get("Content-Type"))
+			}
+
+			var result map[string]interface{}
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				t.Fatalf("failed to decode response body: %v", err)
+			}
+
+			if tt.expected.hasError {
+				if _, ok := result["type"]; !ok {
+					t.Errorf("expected error response to have 'type' field")
+				}
+				return
+			}
+
+			if result["total"] != tt.expected.total {
+				t.Errorf("expected total %v, got %v", tt.expected.total, result["total"])
+			}
+			for _, field := range []string{"by_status", "by_priority", "overdue", "completion_rate"} {
+				if _, ok := result[field]; !ok {
+					t.Errorf("expected success response to have %q field", field)
+				}
+			}
+		})
+	}
+}
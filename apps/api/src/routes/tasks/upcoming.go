@@ -0,0 +1,37 @@
+package tasks
+
+import (
+	"api/src/domain/model"
+	"api/src/routes/response"
+	"context"
+	"net/http"
+	"time"
+	"utils/types"
+)
+
+type upcomingResponse struct {
+	Tasks []taskItem `json:"tasks"`
+}
+
+func (h Handler) Upcoming(w http.ResponseWriter, r *http.Request) {
+	res := types.PipeCtx2(
+		r.Context(),
+		newUpcomingRequest(r).toWindow(),
+		contextError,
+		func(ctx context.Context, within time.Duration) types.Result[[]model.Task, model.AppError] {
+			return h.repo.FindUpcomingTasks(ctx, time.Now().Add(within), model.MaxTaskPageSize)
+		},
+		func(tasks []model.Task) upcomingResponse {
+			return upcomingResponse{Tasks: newTaskItems(tasks)}
+		},
+	)
+
+	res.Match(
+		func(resp upcomingResponse) {
+			response.OK(w, resp)
+		},
+		func(e model.AppError) {
+			response.HandleAppError(w, r, e)
+		},
+	)
+}
@@ -0,0 +1,136 @@
+package tasks
+
+import (
+	"api/src/domain/model"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestUpcomingHandler(t *testing.T) {
+	type args struct {
+		queryParams map[string]string
+	}
+	type expected struct {
+		statusCode int
+		hasError   bool
+		count      int
+	}
+
+	tasks := []model.Task{
+		newDueTask(-time.Hour),
+		newDueTask(time.Hour),
+		newDueTask(48 * time.Hour),
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "default window",
+			args: args{
+				queryParams: map[string]string{},
+			},
+			expected: expected{
+				statusCode: http.StatusOK,
+				hasError:   false,
+				count:      1,
+			},
+		},
+		{
+			testName: "72h window",
+			args: args{
+				queryParams: map[string]string{
+					"within": "72h",
+				},
+			},
+			expected: expected{
+				statusCode: http.StatusOK,
+				hasError:   false,
+				count:      2,
+			},
+		},
+		{
+			testName: "invalid duration",
+			args: args{
+				queryParams: map[string]string{
+					"within": "3 days",
+				},
+			},
+			expected: expected{
+				statusCode: http.StatusBadRequest,
+				hasError:   true,
+			},
+		},
+		{
+			testName: "negative duration",
+			args: args{
+				queryParams: map[string]string{
+					"within": "-1h",
+				},
+			},
+			expected: expected{
+				statusCode: http.StatusBadRequest,
+				hasError:   true,
+			},
+		},
+		{
+			testName: "window too large",
+			args: args{
+				queryParams: map[string]string{
+					"within": "10000h",
+				},
+			},
+			expected: expected{
+				statusCode: http.StatusBadRequest,
+				hasError:   true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/tasks/upcoming", nil)
+			q := req.URL.Query()
+			for k, v := range tt.args.queryParams {
+				q.Add(k, v)
+			}
+			req.URL.RawQuery = q.Encode()
+
+			w := httptest.NewRecorder()
+			newTestHandler(tasks...).Upcoming(w, req)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.expected.statusCode {
+				t.Errorf("expected status %v, got %v", tt.expected.statusCode, resp.StatusCode)
+			}
+
+			if resp.Header.Get("Content-Type") != "application/json" {
+				t.Errorf("expected Content-Type application/json, got %v", resp.Header.Get("Content-Type"))
+			}
+
+			var result map[string]interface{}
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				t.Fatalf("failed to decode response body: %v", err)
+			}
+
+			if tt.expected.hasError {
+				if _, ok := result["type"]; !ok {
+					t.Errorf("expected error response to have 'type' field")
+				}
+			} else {
+				items, ok := result["tasks"].([]interface{})
+				if !ok {
+					t.Fatalf("expected success response to have 'tasks' field")
+				}
+				if len(items) != tt.expected.count {
+					t.Errorf("expected %d tasks, got %d", tt.expected.count, len(items))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package tasks
+
+import (
+	"api/src/domain/model"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"utils/types"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// validate checks request structs. Field errors are reported under the
+// field's JSON name so that clients can map them back to their input.
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterTagNameFunc(func(f reflect.StructField) string {
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+	return v
+}
+
+// validationFailedMessage is shown to clients in place of the validator's
+// text, which names Go types; the per-field reasons are in the details.
+const validationFailedMessage = "request validation failed"
+
+// newValidationError wraps err in a ValidationError for the request named dName,
+// listing every field that failed a validator rule.
+func newValidationError(err error, dName string) model.ValidationError {
+	e := model.NewValidationError(err, dName, model.WithPublicMessage(validationFailedMessage))
+	var fieldErrs validator.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return e
+	}
+	details := make([]model.FieldError, len(fieldErrs))
+	for i, fe := range fieldErrs {
+		details[i] = newFieldDetail(fe.Field(), fe.Tag(), fe.Param())
+	}
+	return e.WithDetails(details...)
+}
+
+// newFieldError wraps err in a ValidationError that blames field for breaking rule.
+func newFieldError(err error, dName, field, rule, param string) model.ValidationError {
+	return model.NewValidationError(err, dName, model.WithPublicMessage(validationFailedMessage)).
+		WithDetails(newFieldDetail(field, rule, param))
+}
+
+// asField attributes a failure of r to the request field named field, so that
+// parse errors are reported in the same shape as validator rule failures.
+func asField[T any](r types.Result[T, model.AppError], field, rule, param string) types.Result[T, model.AppError] {
+	return types.MapErr(r, func(e model.AppError) model.AppError {
+		return newFieldError(e.Unwrap(), e.DomainName(), field, rule, param)
+	})
+}
+
+// collectValidation turns the failures accumulated in v into a single error.
+// A lone failure is returned unchanged; several are joined into one
+// ValidationError for the request named dName that keeps every field detail
+// and the underlying causes, without repeating the wrappers' prefixes.
+func collectValidation[T any](v types.Validation[T, model.AppError], dName string) types.Result[T, model.AppError] {
+	return types.MapErr(v.ToResult(), func(errs []model.AppError) model.AppError {
+		if len(errs) == 1 {
+			return errs[0]
+		}
+		causes := make([]error, 0, len(errs))
+		var details []model.FieldError
+		for _, e := range errs {
+			cause := error(e)
+			if ve, ok := e.(model.ValidationError); ok {
+				details = append(details, ve.Details()...)
+				if ve.Unwrap() != nil {
+					cause = ve.Unwrap()
+				}
+			}
+			causes = append(causes, cause)
+		}
+		return model.NewValidationError(errors.Join(causes...), dName, model.WithPublicMessage(validationFailedMessage)).
+			WithDetails(details...)
+	})
+}
+
+// enumParam formats the allowed values of an enum as a oneof rule parameter.
+func enumParam[T ~string](values []T) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = string(v)
+	}
+	return strings.Join(s, " ")
+}
+
+func newFieldDetail(field, rule, param string) model.FieldError {
+	return model.FieldError{
+		Field:   field,
+		Rule:    rule,
+		Param:   param,
+		Message: ruleMessage(field, rule, param),
+	}
+}
+
+// ruleMessage describes a failed rule in words a client developer can act on.
+func ruleMessage(field, rule, param string) string {
+	switch rule {
+	case "required":
+		return fmt.Sprintf("%s is required", field)
+	case "min":
+		return fmt.Sprintf("%s must be at least %s characters long", field, param)
+	case "max":
+		return fmt.Sprintf("%s must be at most %s characters long", field, param)
+	case "uuid4":
+		return fmt.Sprintf("%s must be a UUID v4", field)
+	case "number":
+		return fmt.Sprintf("%s must be a whole number", field)
+	case "oneof":
+		return fmt.Sprintf("%s must be one of: %s", field, param)
+	case "datetime":
+		return fmt.Sprintf("%s must be a %s timestamp", field, param)
+	case "duration":
+		return fmt.Sprintf("%s must be a duration such as 24h", field)
+	case "range":
+		return fmt.Sprintf("%s must be in the range %s", field, param)
+	case "cursor":
+		return fmt.Sprintf("%s must be a cursor returned by a previous page", field)
+	default:
+		return fmt.Sprintf("%s failed the %s rule", field, rule)
+	}
+}
@@ -6,23 +6,23 @@ package db
 
 import (
 	"context"
-	"database/sql"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
 	CountTasksByStatus(ctx context.Context, status string) (int64, error)
+	CountTasksByStatusAndPriority(ctx context.Context, userID uuid.NullUUID) ([]CountTasksByStatusAndPriorityRow, error)
 	CountTasksByUser(ctx context.Context, userID uuid.NullUUID) (int64, error)
 	CreateTask(ctx context.Context, arg CreateTaskParams) (Task, error)
-	DeleteTask(ctx context.Context, id uuid.UUID) error
+	DeleteTask(ctx context.Context, id uuid.UUID) (int64, error)
 	GetTask(ctx context.Context, id uuid.UUID) (Task, error)
-	ListOverdueTasks(ctx context.Context) ([]Task, error)
-	ListTasks(ctx context.Context) ([]Task, error)
-	ListTasksByStatus(ctx context.Context, status string) ([]Task, error)
-	ListTasksByUser(ctx context.Context, userID uuid.NullUUID) ([]Task, error)
+	ListOverdueTasks(ctx context.Context, pageSize int32) ([]Task, error)
+	ListTasks(ctx context.Context, arg ListTasksParams) ([]Task, error)
+	ListTasksByStatus(ctx context.Context, arg ListTasksByStatusParams) ([]Task, error)
+	ListTasksByUser(ctx context.Context, arg ListTasksByUserParams) ([]Task, error)
 	ListTasksByUserAndStatus(ctx context.Context, arg ListTasksByUserAndStatusParams) ([]Task, error)
-	ListUpcomingTasks(ctx context.Context, dueDate sql.NullTime) ([]Task, error)
+	ListUpcomingTasks(ctx context.Context, arg ListUpcomingTasksParams) ([]Task, error)
 	UpdateTask(ctx context.Context, arg UpdateTaskParams) (Task, error)
 	UpdateTaskStatus(ctx context.Context, arg UpdateTaskStatusParams) (Task, error)
 }
@@ -24,6 +24,55 @@ func (q *Queries) CountTasksByStatus(ctx context.Context, status string) (int64,
 	return count, err
 }
 
+const countTasksByStatusAndPriority = `-- name: CountTasksByStatusAndPriority :many
+SELECT
+    status,
+    priority,
+    COUNT(*) AS count,
+    COUNT(*) FILTER (
+        WHERE due_date < NOW()
+          AND status NOT IN ('completed', 'cancelled')
+    ) AS overdue
+FROM tasks
+WHERE $1::uuid IS NULL OR user_id = $1::uuid
+GROUP BY status, priority
+`
+
+type CountTasksByStatusAndPriorityRow struct {
+	Status   string `json:"status"`
+	Priority string `json:"priority"`
+	Count    int64  `json:"count"`
+	Overdue  int64  `json:"overdue"`
+}
+
+func (q *Queries) CountTasksByStatusAndPriority(ctx context.Context, userID uuid.NullUUID) ([]CountTasksByStatusAndPriorityRow, error) {
+	rows, err := q.db.QueryContext(ctx, countTasksByStatusAndPriority, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountTasksByStatusAndPriorityRow
+	for rows.Next() {
+		var i CountTasksByStatusAndPriorityRow
+		if err := rows.Scan(
+			&i.Status,
+			&i.Priority,
+			&i.Count,
+			&i.Overdue,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const countTasksByUser = `-- name: CountTasksByUser :one
 SELECT COUNT(*) FROM tasks
 WHERE user_id = $1
@@ -83,14 +132,17 @@ func (q *Queries) CreateTask(ctx context.Context, arg CreateTaskParams) (Task, e
 	return i, err
 }
 
-const deleteTask = `-- name: DeleteTask :exec
+const deleteTask = `-- name: DeleteTask :execrows
 DELETE FROM tasks
 WHERE id = $1
 `
 
-func (q *Queries) DeleteTask(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, deleteTask, id)
-	return err
+func (q *Queries) DeleteTask(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteTask, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
 const getTask = `-- name: GetTask :one
@@ -121,10 +173,11 @@ SELECT id, title, description, status, priority, due_date, created_at, updated_a
 WHERE due_date < NOW()
   AND status NOT IN ('completed', 'cancelled')
 ORDER BY due_date ASC
+LIMIT $1
 `
 
-func (q *Queries) ListOverdueTasks(ctx context.Context) ([]Task, error) {
-	rows, err := q.db.QueryContext(ctx, listOverdueTasks)
+func (q *Queries) ListOverdueTasks(ctx context.Context, pageSize int32) ([]Task, error) {
+	rows, err := q.db.QueryContext(ctx, listOverdueTasks, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -159,11 +212,27 @@ func (q *Queries) ListOverdueTasks(ctx context.Context) ([]Task, error) {
 
 const listTasks = `-- name: ListTasks :many
 SELECT id, title, description, status, priority, due_date, created_at, updated_at, completed_at, user_id FROM tasks
-ORDER BY created_at DESC
+WHERE ($1::varchar IS NULL OR priority = $1::varchar)
+  AND ($2::timestamptz IS NULL
+    OR (created_at, id) < ($2::timestamptz, $3::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $4
 `
 
-func (q *Queries) ListTasks(ctx context.Context) ([]Task, error) {
-	rows, err := q.db.QueryContext(ctx, listTasks)
+type ListTasksParams struct {
+	Priority        sql.NullString `json:"priority"`
+	CursorCreatedAt sql.NullTime   `json:"cursor_created_at"`
+	CursorID        uuid.NullUUID  `json:"cursor_id"`
+	PageSize        int32          `json:"page_size"`
+}
+
+func (q *Queries) ListTasks(ctx context.Context, arg ListTasksParams) ([]Task, error) {
+	rows, err := q.db.QueryContext(ctx, listTasks,
+		arg.Priority,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageSize,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -199,11 +268,29 @@ func (q *Queries) ListTasks(ctx context.Context) ([]Task, error) {
 const listTasksByStatus = `-- name: ListTasksByStatus :many
 SELECT id, title, description, status, priority, due_date, created_at, updated_at, completed_at, user_id FROM tasks
 WHERE status = $1
-ORDER BY created_at DESC
+  AND ($2::varchar IS NULL OR priority = $2::varchar)
+  AND ($3::timestamptz IS NULL
+    OR (created_at, id) < ($3::timestamptz, $4::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $5
 `
 
-func (q *Queries) ListTasksByStatus(ctx context.Context, status string) ([]Task, error) {
-	rows, err := q.db.QueryContext(ctx, listTasksByStatus, status)
+type ListTasksByStatusParams struct {
+	Status          string         `json:"status"`
+	Priority        sql.NullString `json:"priority"`
+	CursorCreatedAt sql.NullTime   `json:"cursor_created_at"`
+	CursorID        uuid.NullUUID  `json:"cursor_id"`
+	PageSize        int32          `json:"page_size"`
+}
+
+func (q *Queries) ListTasksByStatus(ctx context.Context, arg ListTasksByStatusParams) ([]Task, error) {
+	rows, err := q.db.QueryContext(ctx, listTasksByStatus,
+		arg.Status,
+		arg.Priority,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageSize,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -239,11 +326,29 @@ func (q *Queries) ListTasksByStatus(ctx context.Context, status string) ([]Task,
 const listTasksByUser = `-- name: ListTasksByUser :many
 SELECT id, title, description, status, priority, due_date, created_at, updated_at, completed_at, user_id FROM tasks
 WHERE user_id = $1
-ORDER BY created_at DESC
+  AND ($2::varchar IS NULL OR priority = $2::varchar)
+  AND ($3::timestamptz IS NULL
+    OR (created_at, id) < ($3::timestamptz, $4::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $5
 `
 
-func (q *Queries) ListTasksByUser(ctx context.Context, userID uuid.NullUUID) ([]Task, error) {
-	rows, err := q.db.QueryContext(ctx, listTasksByUser, userID)
+type ListTasksByUserParams struct {
+	UserID          uuid.NullUUID  `json:"user_id"`
+	Priority        sql.NullString `json:"priority"`
+	CursorCreatedAt sql.NullTime   `json:"cursor_created_at"`
+	CursorID        uuid.NullUUID  `json:"cursor_id"`
+	PageSize        int32          `json:"page_size"`
+}
+
+func (q *Queries) ListTasksByUser(ctx context.Context, arg ListTasksByUserParams) ([]Task, error) {
+	rows, err := q.db.QueryContext(ctx, listTasksByUser,
+		arg.UserID,
+		arg.Priority,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageSize,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -279,16 +384,31 @@ func (q *Queries) ListTasksByUser(ctx context.Context, userID uuid.NullUUID) ([]
 const listTasksByUserAndStatus = `-- name: ListTasksByUserAndStatus :many
 SELECT id, title, description, status, priority, due_date, created_at, updated_at, completed_at, user_id FROM tasks
 WHERE user_id = $1 AND status = $2
-ORDER BY created_at DESC
+  AND ($3::varchar IS NULL OR priority = $3::varchar)
+  AND ($4::timestamptz IS NULL
+    OR (created_at, id) < ($4::timestamptz, $5::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT $6
 `
 
 type ListTasksByUserAndStatusParams struct {
-	UserID uuid.NullUUID `json:"user_id"`
-	Status string        `json:"status"`
+	UserID          uuid.NullUUID  `json:"user_id"`
+	Status          string         `json:"status"`
+	Priority        sql.NullString `json:"priority"`
+	CursorCreatedAt sql.NullTime   `json:"cursor_created_at"`
+	CursorID        uuid.NullUUID  `json:"cursor_id"`
+	PageSize        int32          `json:"page_size"`
 }
 
 func (q *Queries) ListTasksByUserAndStatus(ctx context.Context, arg ListTasksByUserAndStatusParams) ([]Task, error) {
-	rows, err := q.db.QueryContext(ctx, listTasksByUserAndStatus, arg.UserID, arg.Status)
+	rows, err := q.db.QueryContext(ctx, listTasksByUserAndStatus,
+		arg.UserID,
+		arg.Status,
+		arg.Priority,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageSize,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -326,10 +446,16 @@ SELECT id, title, description, status, priority, due_date, created_at, updated_a
 WHERE due_date BETWEEN NOW() AND $1
   AND status NOT IN ('completed', 'cancelled')
 ORDER BY due_date ASC
+LIMIT $2
 `
 
-func (q *Queries) ListUpcomingTasks(ctx context.Context, dueDate sql.NullTime) ([]Task, error) {
-	rows, err := q.db.QueryContext(ctx, listUpcomingTasks, dueDate)
+type ListUpcomingTasksParams struct {
+	DueDate  sql.NullTime `json:"due_date"`
+	PageSize int32        `json:"page_size"`
+}
+
+func (q *Queries) ListUpcomingTasks(ctx context.Context, arg ListUpcomingTasksParams) ([]Task, error) {
+	rows, err := q.db.QueryContext(ctx, listUpcomingTasks, arg.DueDate, arg.PageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -366,10 +492,10 @@ const updateTask = `-- name: UpdateTask :one
 UPDATE tasks
 SET
     title = COALESCE($2, title),
-    description = COALESCE($3, description),
+    description = $3,
     status = COALESCE($4, status),
     priority = COALESCE($5, priority),
-    due_date = COALESCE($6, due_date),
+    due_date = $6,
     updated_at = NOW()
 WHERE id = $1
 RETURNING id, title, description, status, priority, due_date, created_at, updated_at, completed_at, user_id
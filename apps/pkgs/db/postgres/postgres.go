@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+	"utils/env"
+	"utils/logger"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+// Config holds the connection and pool settings for a Postgres database.
+type Config struct {
+	Host     string
+	Port     int
+	DBName   string
+	User     string
+	Password string
+	SSLMode  string
+
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+
+	// PingAttempts is the number of times Open pings the database before giving up.
+	PingAttempts int
+	// PingInterval is the delay between ping attempts.
+	PingInterval time.Duration
+}
+
+// ConfigFromEnv builds a Config from the DB_* environment variables.
+// Connection settings match .devcontainer/compose.override.yaml; pool and
+// retry settings fall back to defaults suitable for a single API instance.
+func ConfigFromEnv() Config {
+	return Config{
+		Host:     env.GetString("DB_HOST", "localhost"),
+		Port:     env.GetInt("DB_PORT", 5432),
+		DBName:   env.GetString("DB_DBNAME", "postgres"),
+		User:     env.GetString("DB_USERNAME", "postgres"),
+		Password: env.GetString("DB_PASSWORD", ""),
+		SSLMode:  env.GetString("DB_SSLMODE", "disable"),
+
+		MaxOpenConns:    env.GetInt("DB_MAX_OPEN_CONNS", 25),
+		MaxIdleConns:    env.GetInt("DB_MAX_IDLE_CONNS", 25),
+		ConnMaxLifetime: time.Duration(env.GetInt("DB_CONN_MAX_LIFETIME_SECONDS", 300)) * time.Second,
+		ConnMaxIdleTime: time.Duration(env.GetInt("DB_CONN_MAX_IDLE_TIME_SECONDS", 60)) * time.Second,
+
+		PingAttempts: env.GetInt("DB_PING_ATTEMPTS", 10),
+		PingInterval: time.Duration(env.GetInt("DB_PING_INTERVAL_SECONDS", 2)) * time.Second,
+	}
+}
+
+// DSN returns the connection URL for the configuration.
+// User name and password are escaped, so they may contain any character.
+func (c Config) DSN() string {
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(c.User, c.Password),
+		Host:     net.JoinHostPort(c.Host, strconv.Itoa(c.Port)),
+		Path:     "/" + c.DBName,
+		RawQuery: url.Values{"sslmode": {c.SSLMode}}.Encode(),
+	}
+	return u.String()
+}
+
+// Open creates a connection pool for cfg and waits until the database answers a ping.
+// It retries up to cfg.PingAttempts times, cfg.PingInterval apart, and stops early
+// when ctx is done. The caller is responsible for closing the returned *sql.DB.
+func Open(ctx context.Context, cfg Config) (*sql.DB, error) {
+	conn, err := sql.Open("pgx", cfg.DSN())
+	if err != nil {
+		return nil, fmt.Errorf("open database: %w", err)
+	}
+	conn.SetMaxOpenConns(cfg.MaxOpenConns)
+	conn.SetMaxIdleConns(cfg.MaxIdleConns)
+	conn.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	conn.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+
+	if err := ping(ctx, conn, cfg); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// ping pings the database until it succeeds, the attempts run out or ctx is done.
+func ping(ctx context.Context, conn *sql.DB, cfg Config) error {
+	attempts := max(cfg.PingAttempts, 1)
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if err = conn.PingContext(ctx); err == nil {
+			return nil
+		}
+		if attempt == attempts {
+			break
+		}
+		logger.Warn("Database not ready, retrying",
+			"attempt", attempt,
+			"max_attempts", attempts,
+			"error", err.Error(),
+		)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("ping database: %w", ctx.Err())
+		case <-time.After(cfg.PingInterval):
+		}
+	}
+	return fmt.Errorf("ping database after %d attempts: %w", attempts, err)
+}
@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+	"utils/logger"
+)
+
+func TestConfigDSN(t *testing.T) {
+	type args struct {
+		cfg Config
+	}
+	type expected struct {
+		dsn string
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "plain credentials",
+			args: args{
+				cfg: Config{Host: "db", Port: 5432, DBName: "mydb", User: "postgres", Password: "postgres", SSLMode: "disable"},
+			},
+			expected: expected{
+				dsn: "postgres://postgres:postgres@db:5432/mydb?sslmode=disable",
+			},
+		},
+		{
+			testName: "credentials with reserved characters",
+			args: args{
+				cfg: Config{Host: "db", Port: 5432, DBName: "mydb", User: "app", Password: "p@ss/w:rd", SSLMode: "require"},
+			},
+			expected: expected{
+				dsn: "postgres://app:p%40ss%2Fw%3Ard@db:5432/mydb?sslmode=require",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			if got := tt.args.cfg.DSN(); got != tt.expected.dsn {
+				t.Errorf("expected dsn %q, got %q", tt.expected.dsn, got)
+			}
+		})
+	}
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("DB_HOST", "db")
+	t.Setenv("DB_PORT", "6543")
+	t.Setenv("DB_MAX_OPEN_CONNS", "7")
+	t.Setenv("DB_CONN_MAX_LIFETIME_SECONDS", "30")
+
+	cfg := ConfigFromEnv()
+
+	if cfg.Host != "db" || cfg.Port != 6543 {
+		t.Errorf("expected db:6543, got %s:%d", cfg.Host, cfg.Port)
+	}
+	if cfg.MaxOpenConns != 7 {
+		t.Errorf("expected max open conns 7, got %d", cfg.MaxOpenConns)
+	}
+	if cfg.ConnMaxLifetime != 30*time.Second {
+		t.Errorf("expected conn max lifetime 30s, got %s", cfg.ConnMaxLifetime)
+	}
+}
+
+func TestOpenStopsWhenContextDone(t *testing.T) {
+	logger.Init()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cfg := Config{Host: "127.0.0.1", Port: 1, DBName: "none", User: "none", SSLMode: "disable", PingAttempts: 3, PingInterval: time.Hour}
+	if _, err := Open(ctx, cfg); err == nil {
+		t.Fatalf("expected error when context is cancelled")
+	}
+}
@@ -16,31 +16,47 @@ WHERE id = $1;
 
 -- name: ListTasks :many
 SELECT * FROM tasks
-ORDER BY created_at DESC;
+WHERE (sqlc.narg('priority')::varchar IS NULL OR priority = sqlc.narg('priority')::varchar)
+  AND (sqlc.narg('cursor_created_at')::timestamptz IS NULL
+    OR (created_at, id) < (sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_id')::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('page_size');
 
 -- name: ListTasksByUser :many
 SELECT * FROM tasks
-WHERE user_id = $1
-ORDER BY created_at DESC;
+WHERE user_id = sqlc.arg('user_id')
+  AND (sqlc.narg('priority')::varchar IS NULL OR priority = sqlc.narg('priority')::varchar)
+  AND (sqlc.narg('cursor_created_at')::timestamptz IS NULL
+    OR (created_at, id) < (sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_id')::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('page_size');
 
 -- name: ListTasksByStatus :many
 SELECT * FROM tasks
-WHERE status = $1
-ORDER BY created_at DESC;
+WHERE status = sqlc.arg('status')
+  AND (sqlc.narg('priority')::varchar IS NULL OR priority = sqlc.narg('priority')::varchar)
+  AND (sqlc.narg('cursor_created_at')::timestamptz IS NULL
+    OR (created_at, id) < (sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_id')::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('page_size');
 
 -- name: ListTasksByUserAndStatus :many
 SELECT * FROM tasks
-WHERE user_id = $1 AND status = $2
-ORDER BY created_at DESC;
+WHERE user_id = sqlc.arg('user_id') AND status = sqlc.arg('status')
+  AND (sqlc.narg('priority')::varchar IS NULL OR priority = sqlc.narg('priority')::varchar)
+  AND (sqlc.narg('cursor_created_at')::timestamptz IS NULL
+    OR (created_at, id) < (sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_id')::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('page_size');
 
 -- name: UpdateTask :one
 UPDATE tasks
 SET
     title = COALESCE(sqlc.narg('title'), title),
-    description = COALESCE(sqlc.narg('description'), description),
+    description = sqlc.narg('description'),
     status = COALESCE(sqlc.narg('status'), status),
     priority = COALESCE(sqlc.narg('priority'), priority),
-    due_date = COALESCE(sqlc.narg('due_date'), due_date),
+    due_date = sqlc.narg('due_date'),
     updated_at = NOW()
 WHERE id = $1
 RETURNING *;
@@ -54,7 +70,7 @@ SET
 WHERE id = $1
 RETURNING *;
 
--- name: DeleteTask :exec
+-- name: DeleteTask :execrows
 DELETE FROM tasks
 WHERE id = $1;
 
@@ -66,14 +82,29 @@ WHERE status = $1;
 SELECT COUNT(*) FROM tasks
 WHERE user_id = $1;
 
+-- name: CountTasksByStatusAndPriority :many
+SELECT
+    status,
+    priority,
+    COUNT(*) AS count,
+    COUNT(*) FILTER (
+        WHERE due_date < NOW()
+          AND status NOT IN ('completed', 'cancelled')
+    ) AS overdue
+FROM tasks
+WHERE sqlc.narg('user_id')::uuid IS NULL OR user_id = sqlc.narg('user_id')::uuid
+GROUP BY status, priority;
+
 -- name: ListOverdueTasks :many
 SELECT * FROM tasks
 WHERE due_date < NOW()
   AND status NOT IN ('completed', 'cancelled')
-ORDER BY due_date ASC;
+ORDER BY due_date ASC
+LIMIT sqlc.arg('page_size');
 
 -- name: ListUpcomingTasks :many
 SELECT * FROM tasks
-WHERE due_date BETWEEN NOW() AND $1
+WHERE due_date BETWEEN NOW() AND sqlc.arg('due_date')
   AND status NOT IN ('completed', 'cancelled')
-ORDER BY due_date ASC;
+ORDER BY due_date ASC
+LIMIT sqlc.arg('page_size');
@@ -1,11 +1,13 @@
 package logger
 
 import (
+	"context"
 	"log/slog"
 	"os"
 )
 
-var log *slog.Logger
+// log - Init前はslogのデフォルトロガーを使う
+var log = slog.Default()
 
 // Init - JSON形式のロガーを初期化
 func Init() {
@@ -34,3 +36,8 @@ func Warn(msg string, args ...any) {
 func Error(msg string, args ...any) {
 	log.Error(msg, args...)
 }
+
+// Log - 指定したレベルでログを出力
+func Log(ctx context.Context, level slog.Level, msg string, args ...any) {
+	log.Log(ctx, level, msg, args...)
+}
@@ -0,0 +1,100 @@
+package types
+
+import "context"
+
+// CtxErrFunc - キャンセルまたは期限切れになったcontextの原因をパイプラインのエラー型に変換する
+type CtxErrFunc[E any] func(cause error) E
+
+// checkCtx - ctxが終了していればonDoneで変換したエラーを返す
+func checkCtx[E any](ctx context.Context, onDone CtxErrFunc[E]) (E, bool) {
+	if ctx.Err() != nil {
+		return onDone(context.Cause(ctx)), true
+	}
+	var zero E
+	return zero, false
+}
+
+// FlatMapCtx - contextを受け取るFlatMap
+// ctxがキャンセル済みまたは期限切れの場合はfnを呼ばずにonDoneのエラーを返す
+func FlatMapCtx[T, U, E any](
+	ctx context.Context,
+	r Result[T, E],
+	onDone CtxErrFunc[E],
+	fn func(context.Context, T) Result[U, E],
+) Result[U, E] {
+	return FlatMap(r, func(v T) Result[U, E] {
+		if e, done := checkCtx(ctx, onDone); done {
+			return Err[U](e)
+		}
+		return fn(ctx, v)
+	})
+}
+
+// MapCtx - contextを受け取るMap
+// ctxがキャンセル済みまたは期限切れの場合はfnを呼ばずにonDoneのエラーを返す
+func MapCtx[T, U, E any](
+	ctx context.Context,
+	r Result[T, E],
+	onDone CtxErrFunc[E],
+	fn func(context.Context, T) U,
+) Result[U, E] {
+	return FlatMapCtx(ctx, r, onDone, func(ctx context.Context, v T) Result[U, E] {
+		return Ok[U, E](fn(ctx, v))
+	})
+}
+
+// PipeCtx2 chains: FlatMapCtx -> Map
+// A --(f1)--> B --(f2)--> C
+// The context is checked before every step that receives it. The final Map
+// always runs, so work that already succeeded is not reported as cancelled.
+func PipeCtx2[A, B, C, Err any](
+	ctx context.Context,
+	r Result[A, Err],
+	onDone CtxErrFunc[Err],
+	f1 func(context.Context, A) Result[B, Err],
+	f2 func(B) C,
+) Result[C, Err] {
+	return Map(FlatMapCtx(ctx, r, onDone, f1), f2)
+}
+
+// PipeCtx3 chains: FlatMapCtx -> FlatMapCtx -> Map
+// A --(f1)--> B --(f2)--> C --(f3)--> D
+func PipeCtx3[A, B, C, D, Err any](
+	ctx context.Context,
+	r Result[A, Err],
+	onDone CtxErrFunc[Err],
+	f1 func(context.Context, A) Result[B, Err],
+	f2 func(context.Context, B) Result[C, Err],
+	f3 func(C) D,
+) Result[D, Err] {
+	return Map(FlatMapCtx(ctx, FlatMapCtx(ctx, r, onDone, f1), onDone, f2), f3)
+}
+
+// PipeCtx4 chains: FlatMapCtx -> FlatMapCtx -> FlatMapCtx -> Map
+// A --(f1)--> B --(f2)--> C --(f3)--> D --(f4)--> E
+func PipeCtx4[A, B, C, D, E, Err any](
+	ctx context.Context,
+	r Result[A, Err],
+	onDone CtxErrFunc[Err],
+	f1 func(context.Context, A) Result[B, Err],
+	f2 func(context.Context, B) Result[C, Err],
+	f3 func(context.Context, C) Result[D, Err],
+	f4 func(D) E,
+) Result[E, Err] {
+	return Map(FlatMapCtx(ctx, FlatMapCtx(ctx, FlatMapCtx(ctx, r, onDone, f1), onDone, f2), onDone, f3), f4)
+}
+
+// PipeCtx5 chains: FlatMapCtx -> FlatMapCtx -> FlatMapCtx -> FlatMapCtx -> Map
+// A --(f1)--> B --(f2)--> C --(f3)--> D --(f4)--> E --(f5)--> F
+func PipeCtx5[A, B, C, D, E, F, Err any](
+	ctx context.Context,
+	r Result[A, Err],
+	onDone CtxErrFunc[Err],
+	f1 func(context.Context, A) Result[B, Err],
+	f2 func(context.Context, B) Result[C, Err],
+	f3 func(context.Context, C) Result[D, Err],
+	f4 func(context.Context, D) Result[E, Err],
+	f5 func(E) F,
+) Result[F, Err] {
+	return Map(FlatMapCtx(ctx, FlatMapCtx(ctx, FlatMapCtx(ctx, FlatMapCtx(ctx, r, onDone, f1), onDone, f2), onDone, f3), onDone, f4), f5)
+}
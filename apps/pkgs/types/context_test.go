@@ -0,0 +1,130 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPipeCtx2(t *testing.T) {
+	onDone := func(cause error) string { return "done: " + cause.Error() }
+
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	type args struct {
+		ctx context.Context
+		r   Result[int, string]
+	}
+	type expected struct {
+		value  int
+		err    string
+		called bool
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "runs every step",
+			args: args{
+				ctx: context.Background(),
+				r:   Ok[int, string](1),
+			},
+			expected: expected{value: 4, called: true},
+		},
+		{
+			testName: "canceled",
+			args: args{
+				ctx: canceled,
+				r:   Ok[int, string](1),
+			},
+			expected: expected{err: "done: " + context.Canceled.Error()},
+		},
+		{
+			testName: "deadline exceeded",
+			args: args{
+				ctx: expired,
+				r:   Ok[int, string](1),
+			},
+			expected: expected{err: "done: " + context.DeadlineExceeded.Error()},
+		},
+		{
+			testName: "earlier error wins over cancellation",
+			args: args{
+				ctx: canceled,
+				r:   Err[int]("invalid"),
+			},
+			expected: expected{err: "invalid"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			called := false
+			res := PipeCtx2(
+				tt.args.ctx,
+				tt.args.r,
+				onDone,
+				func(ctx context.Context, v int) Result[int, string] {
+					called = true
+					return Ok[int, string](v + 1)
+				},
+				func(v int) int { return v * 2 },
+			)
+
+			res.Match(
+				func(v int) {
+					if tt.expected.err != "" {
+						t.Fatalf("expected error %q, got %d", tt.expected.err, v)
+					}
+					if v != tt.expected.value {
+						t.Errorf("expected %d, got %d", tt.expected.value, v)
+					}
+				},
+				func(e string) {
+					if e != tt.expected.err {
+						t.Errorf("expected error %q, got %q", tt.expected.err, e)
+					}
+				},
+			)
+			if called != tt.expected.called {
+				t.Errorf("expected step called to be %v", tt.expected.called)
+			}
+		})
+	}
+}
+
+func TestFlatMapCtxStopsAfterCancel(t *testing.T) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cause := errors.New("client gone")
+
+	res := PipeCtx3(
+		ctx,
+		Ok[int, error](1),
+		func(c error) error { return c },
+		func(ctx context.Context, v int) Result[int, error] {
+			cancel(cause)
+			return Ok[int, error](v)
+		},
+		func(ctx context.Context, v int) Result[int, error] {
+			t.Fatal("step after cancellation must not run")
+			return Ok[int, error](v)
+		},
+		func(v int) int { return v },
+	)
+
+	res.Match(
+		func(v int) { t.Fatalf("expected error, got %d", v) },
+		func(e error) {
+			if !errors.Is(e, cause) {
+				t.Errorf("expected cause %v, got %v", cause, e)
+			}
+		},
+	)
+}
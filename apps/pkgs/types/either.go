@@ -0,0 +1,83 @@
+package types
+
+// Either型 - 2種類の値のどちらか一方を表す
+// Resultと異なり、どちらの側も失敗を意味しない(例: 作成済みか新規作成か)
+// ゼロ値はLのゼロ値を持つLeft
+type Either[L, R any] struct {
+	left    L
+	right   R
+	isRight bool
+}
+
+// コンストラクタ
+func Left[L, R any](value L) Either[L, R] {
+	return Either[L, R]{left: value}
+}
+
+func Right[L, R any](value R) Either[L, R] {
+	return Either[L, R]{right: value, isRight: true}
+}
+
+// 判定メソッド
+func (e Either[L, R]) IsLeft() bool {
+	return !e.isRight
+}
+
+func (e Either[L, R]) IsRight() bool {
+	return e.isRight
+}
+
+// GetLeft - Leftの値と、Leftかどうかを返す
+func (e Either[L, R]) GetLeft() (L, bool) {
+	return e.left, !e.isRight
+}
+
+// GetRight - Rightの値と、Rightかどうかを返す
+func (e Either[L, R]) GetRight() (R, bool) {
+	return e.right, e.isRight
+}
+
+// Map系関数
+func MapLeft[L, R, M any](e Either[L, R], fn func(L) M) Either[M, R] {
+	if e.isRight {
+		return Right[M](e.right)
+	}
+	return Left[M, R](fn(e.left))
+}
+
+func MapRight[L, R, S any](e Either[L, R], fn func(R) S) Either[L, S] {
+	if e.isRight {
+		return Right[L](fn(e.right))
+	}
+	return Left[L, S](e.left)
+}
+
+// MatchEither - 側に応じてonLeftかonRightを呼び、その戻り値を返す
+func MatchEither[L, R, T any](e Either[L, R], onLeft func(L) T, onRight func(R) T) T {
+	if e.isRight {
+		return onRight(e.right)
+	}
+	return onLeft(e.left)
+}
+
+// MatchOption - Someならばその値でonSomeを、NoneならonNoneを呼び、その戻り値を返す
+func MatchOption[T, U any](o Option[T], onSome func(T) U, onNone func() U) U {
+	if o.ok {
+		return onSome(o.value)
+	}
+	return onNone()
+}
+
+// Fold - ResultをonOkかonErrで1つの値に畳み込む
+// Matchと異なり、副作用ではなく戻り値で結果を受け取れる
+func Fold[T, E, U any](r Result[T, E], onOk func(T) U, onErr func(E) U) U {
+	if r.err != nil {
+		return onErr(*r.err)
+	}
+	return onOk(*r.value)
+}
+
+// ToEither - ResultをErrがLeft、OkがRightのEitherに変換する
+func ToEither[T, E any](r Result[T, E]) Either[E, T] {
+	return Fold(r, Right[E, T], Left[E, T])
+}
@@ -0,0 +1,141 @@
+package types
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestMatchEither(t *testing.T) {
+	type args struct {
+		e Either[string, int]
+	}
+	type expected struct {
+		value   string
+		isRight bool
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "left",
+			args:     args{e: Left[string, int]("existing")},
+			expected: expected{value: "left existing"},
+		},
+		{
+			testName: "right",
+			args:     args{e: Right[string](1)},
+			expected: expected{value: "right 1", isRight: true},
+		},
+		{
+			testName: "zero value is left",
+			args:     args{e: Either[string, int]{}},
+			expected: expected{value: "left "},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			got := MatchEither(tt.args.e,
+				func(l string) string { return "left " + l },
+				func(r int) string { return "right " + strconv.Itoa(r) },
+			)
+			if got != tt.expected.value {
+				t.Errorf("expected %q, got %q", tt.expected.value, got)
+			}
+			if tt.args.e.IsRight() != tt.expected.isRight || tt.args.e.IsLeft() == tt.expected.isRight {
+				t.Errorf("expected isRight %v", tt.expected.isRight)
+			}
+		})
+	}
+}
+
+func TestMapEither(t *testing.T) {
+	right := MapRight(MapLeft(Right[string](2), func(l string) int { return len(l) }), strconv.Itoa)
+	if v, ok := right.GetRight(); !ok || v != "2" {
+		t.Errorf("expected Right 2, got %v", right)
+	}
+
+	left := MapRight(MapLeft(Left[string, int]("abc"), func(l string) int { return len(l) }), strconv.Itoa)
+	if v, ok := left.GetLeft(); !ok || v != 3 {
+		t.Errorf("expected Left 3, got %v", left)
+	}
+}
+
+func TestFold(t *testing.T) {
+	type args struct {
+		r Result[int, string]
+	}
+	type expected struct {
+		value string
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "ok",
+			args:     args{r: Ok[int, string](1)},
+			expected: expected{value: "ok 1"},
+		},
+		{
+			testName: "err",
+			args:     args{r: Err[int]("invalid")},
+			expected: expected{value: "err invalid"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			got := Fold(tt.args.r,
+				func(v int) string { return "ok " + strconv.Itoa(v) },
+				func(e string) string { return "err " + e },
+			)
+			if got != tt.expected.value {
+				t.Errorf("expected %q, got %q", tt.expected.value, got)
+			}
+		})
+	}
+}
+
+func TestToEither(t *testing.T) {
+	if v, ok := ToEither(Ok[int, string](1)).GetRight(); !ok || v != 1 {
+		t.Errorf("expected Right 1, got %v, %v", v, ok)
+	}
+	if e, ok := ToEither(Err[int]("invalid")).GetLeft(); !ok || e != "invalid" {
+		t.Errorf("expected Left invalid, got %v, %v", e, ok)
+	}
+}
+
+func TestMatchOption(t *testing.T) {
+	describe := func(o Option[int]) string {
+		return MatchOption(o, strconv.Itoa, func() string { return "none" })
+	}
+	if got := describe(Some(1)); got != "1" {
+		t.Errorf("expected 1, got %q", got)
+	}
+	if got := describe(None[int]()); got != "none" {
+		t.Errorf("expected none, got %q", got)
+	}
+}
+
+func TestTuple(t *testing.T) {
+	a, b := NewTuple2(1, "x").Unpack()
+	if a != 1 || b != "x" {
+		t.Errorf("Tuple2: got %v, %v", a, b)
+	}
+
+	c, d, e := NewTuple3(1, "x", true).Unpack()
+	if c != 1 || d != "x" || !e {
+		t.Errorf("Tuple3: got %v, %v, %v", c, d, e)
+	}
+
+	t4 := NewTuple4(1, "x", true, 2.5)
+	if t4.V1 != 1 || t4.V2 != "x" || !t4.V3 || t4.V4 != 2.5 {
+		t.Errorf("Tuple4: got %+v", t4)
+	}
+}
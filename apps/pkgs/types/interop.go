@@ -0,0 +1,111 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// FromPair - Goの慣用的な (T, error) の戻り値をResultに変換する
+// errがnilでなければErr、そうでなければOk
+func FromPair[T any](value T, err error) Result[T, error] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok[T, error](value)
+}
+
+// ToPair - Resultを (T, error) の組に戻す
+// Okの場合はnilのerrorを返す(型付きnilにはならない)
+func ToPair[T any, E error](r Result[T, E]) (T, error) {
+	if r.err != nil {
+		var zero T
+		return zero, *r.err
+	}
+	return *r.value, nil
+}
+
+// Unwrap - Okの値を返す。Errの場合はpanicする
+func (r Result[T, E]) Unwrap() T {
+	if r.err != nil {
+		panic(fmt.Sprintf("called Unwrap on Err: %v", *r.err))
+	}
+	return *r.value
+}
+
+// UnwrapOr - Okの値を返す。Errの場合はfallbackを返す
+func (r Result[T, E]) UnwrapOr(fallback T) T {
+	if r.err != nil {
+		return fallback
+	}
+	return *r.value
+}
+
+// Expect - Okの値を返す。Errの場合はmsgとエラーを添えてpanicする
+func (r Result[T, E]) Expect(msg string) T {
+	if r.err != nil {
+		panic(fmt.Sprintf("%s: %v", msg, *r.err))
+	}
+	return *r.value
+}
+
+// resultJSON - Resultのタグ付きJSON表現 {"ok": ...} または {"err": ...}
+type resultJSON struct {
+	Ok  json.RawMessage `json:"ok,omitempty"`
+	Err json.RawMessage `json:"err,omitempty"`
+}
+
+// MarshalJSON - Okは {"ok": 値}、Errは {"err": エラー} として出力する
+// エラーがjson.Marshalerでないerrorの場合はError()の文字列を出力する
+// ゼロ値のResultはIsOkと同じくOkとして扱い、Tのゼロ値を出力する
+func (r Result[T, E]) MarshalJSON() ([]byte, error) {
+	if r.err == nil {
+		var value T
+		if r.value != nil {
+			value = *r.value
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(resultJSON{Ok: data})
+	}
+
+	var errValue any = *r.err
+	if e, ok := errValue.(error); ok {
+		if _, custom := errValue.(json.Marshaler); !custom {
+			errValue = e.Error()
+		}
+	}
+	data, err := json.Marshal(errValue)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(resultJSON{Err: data})
+}
+
+// UnmarshalJSON - MarshalJSONの出力を読み戻す
+// Eがインターフェース型(errorなど)の場合、Errは復元できない
+func (r *Result[T, E]) UnmarshalJSON(data []byte) error {
+	var raw resultJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	switch {
+	case raw.Err != nil:
+		var e E
+		if err := json.Unmarshal(raw.Err, &e); err != nil {
+			return err
+		}
+		*r = Err[T](e)
+	case raw.Ok != nil:
+		var v T
+		if err := json.Unmarshal(raw.Ok, &v); err != nil {
+			return err
+		}
+		*r = Ok[T, E](v)
+	default:
+		return errors.New(`types: Result JSON must have an "ok" or "err" member`)
+	}
+	return nil
+}
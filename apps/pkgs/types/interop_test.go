@@ -0,0 +1,152 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestFromPairToPair(t *testing.T) {
+	boom := errors.New("boom")
+
+	type args struct {
+		value int
+		err   error
+	}
+	type expected struct {
+		value int
+		err   error
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "ok",
+			args:     args{value: 1},
+			expected: expected{value: 1},
+		},
+		{
+			testName: "err",
+			args:     args{value: 1, err: boom},
+			expected: expected{err: boom},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			r := FromPair(tt.args.value, tt.args.err)
+			if r.IsErr() != (tt.expected.err != nil) {
+				t.Fatalf("expected IsErr %v", tt.expected.err != nil)
+			}
+
+			v, err := ToPair(r)
+			if v != tt.expected.value || !errors.Is(err, tt.expected.err) {
+				t.Errorf("expected (%d, %v), got (%d, %v)", tt.expected.value, tt.expected.err, v, err)
+			}
+			if tt.expected.err == nil && err != nil {
+				t.Errorf("expected untyped nil error, got %#v", err)
+			}
+		})
+	}
+}
+
+func TestUnwrap(t *testing.T) {
+	if v := Ok[int, string](1).Unwrap(); v != 1 {
+		t.Errorf("expected 1, got %d", v)
+	}
+	if v := Err[int]("boom").UnwrapOr(2); v != 2 {
+		t.Errorf("expected fallback 2, got %d", v)
+	}
+
+	defer func() {
+		if r := recover(); r != "loading config: boom" {
+			t.Errorf("expected panic with message, got %v", r)
+		}
+	}()
+	Err[int]("boom").Expect("loading config")
+}
+
+func TestResultJSON(t *testing.T) {
+	type args struct {
+		r Result[[]int, error]
+	}
+	type expected struct {
+		json string
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "ok",
+			args:     args{r: Ok[[]int, error]([]int{1, 2})},
+			expected: expected{json: `{"ok":[1,2]}`},
+		},
+		{
+			testName: "ok null",
+			args:     args{r: Ok[[]int, error](nil)},
+			expected: expected{json: `{"ok":null}`},
+		},
+		{
+			testName: "zero value is ok",
+			args:     args{r: Result[[]int, error]{}},
+			expected: expected{json: `{"ok":null}`},
+		},
+		{
+			testName: "error uses message",
+			args:     args{r: Err[[]int](errors.New("boom"))},
+			expected: expected{json: `{"err":"boom"}`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			data, err := json.Marshal(tt.args.r)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if string(data) != tt.expected.json {
+				t.Errorf("expected %s, got %s", tt.expected.json, data)
+			}
+		})
+	}
+}
+
+func TestResultJSONZeroField(t *testing.T) {
+	var v struct {
+		R Result[int, error] `json:"r"`
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if string(data) != `{"r":{"ok":0}}` {
+		t.Errorf("expected zero Ok, got %s", data)
+	}
+}
+
+func TestResultJSONRoundTrip(t *testing.T) {
+	for _, r := range []Result[int, string]{Ok[int, string](0), Err[int]("boom")} {
+		data, err := json.Marshal(r)
+		if err != nil {
+			t.Fatalf("marshal: %v", err)
+		}
+		var got Result[int, string]
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("unmarshal %s: %v", data, err)
+		}
+		if got.IsOk() != r.IsOk() || got.UnwrapOr(-1) != r.UnwrapOr(-1) {
+			t.Errorf("expected %s to round trip", data)
+		}
+	}
+
+	var got Result[int, string]
+	if err := json.Unmarshal([]byte(`{}`), &got); err == nil {
+		t.Errorf("expected error for untagged JSON")
+	}
+}
@@ -0,0 +1,138 @@
+package types
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// Option型 - 値が存在しない可能性を表す
+// ゼロ値はNone
+type Option[T any] struct {
+	value T
+	ok    bool
+}
+
+// コンストラクタ
+func Some[T any](value T) Option[T] {
+	return Option[T]{value: value, ok: true}
+}
+
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// OptionOf - (値, 有無) の組からOptionを作る。sql.NullXxxの変換に使う
+func OptionOf[T any](value T, ok bool) Option[T] {
+	if !ok {
+		return None[T]()
+	}
+	return Some(value)
+}
+
+// FromPtr - nilならNone、それ以外は参照先の値のSome
+func FromPtr[T any](p *T) Option[T] {
+	if p == nil {
+		return None[T]()
+	}
+	return Some(*p)
+}
+
+// 判定メソッド
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+// Get - 値と有無を返す
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+// OrElse - Noneの場合はfallbackを返す
+func (o Option[T]) OrElse(fallback T) T {
+	if !o.ok {
+		return fallback
+	}
+	return o.value
+}
+
+// Ptr - Noneならnil、それ以外は値のコピーへのポインタを返す
+func (o Option[T]) Ptr() *T {
+	if !o.ok {
+		return nil
+	}
+	v := o.value
+	return &v
+}
+
+// Map系関数
+func MapOption[T, U any](o Option[T], fn func(T) U) Option[U] {
+	if !o.ok {
+		return None[U]()
+	}
+	return Some(fn(o.value))
+}
+
+func FlatMapOption[T, U any](o Option[T], fn func(T) Option[U]) Option[U] {
+	if !o.ok {
+		return None[U]()
+	}
+	return fn(o.value)
+}
+
+// Resultとの相互変換
+// OkOr - SomeはOk、Noneはerrを持つErrに変換する
+func OkOr[T, E any](o Option[T], err E) Result[T, E] {
+	if !o.ok {
+		return Err[T](err)
+	}
+	return Ok[T, E](o.value)
+}
+
+// ToOption - OkはSome、Errはエラーを捨ててNoneに変換する
+func ToOption[T, E any](r Result[T, E]) Option[T] {
+	if r.err != nil {
+		return None[T]()
+	}
+	return Some(*r.value)
+}
+
+// JSON - Noneはnull、Someは値そのものとして扱う
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.ok {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*o = None[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
+
+// database/sql - NULLはNone。値の変換はsql.Null[T]と同じ規則に従う
+func (o *Option[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	*o = OptionOf(n.V, n.Valid)
+	return nil
+}
+
+func (o Option[T]) Value() (driver.Value, error) {
+	return sql.Null[T]{V: o.value, Valid: o.ok}.Value()
+}
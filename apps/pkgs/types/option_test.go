@@ -0,0 +1,127 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestOptionJSON(t *testing.T) {
+	type payload struct {
+		Due Option[time.Time] `json:"due"`
+		N   Option[int]       `json:"n"`
+	}
+	due := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	type args struct {
+		value payload
+	}
+	type expected struct {
+		json string
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "some",
+			args:     args{value: payload{Due: Some(due), N: Some(0)}},
+			expected: expected{json: `{"due":"2026-01-02T03:04:05Z","n":0}`},
+		},
+		{
+			testName: "none",
+			args:     args{value: payload{}},
+			expected: expected{json: `{"due":null,"n":null}`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			data, err := json.Marshal(tt.args.value)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+			if string(data) != tt.expected.json {
+				t.Errorf("expected %s, got %s", tt.expected.json, data)
+			}
+
+			var got payload
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			if got.N != tt.args.value.N || got.Due.IsSome() != tt.args.value.Due.IsSome() {
+				t.Errorf("expected %+v after round trip, got %+v", tt.args.value, got)
+			}
+		})
+	}
+}
+
+func TestOptionScan(t *testing.T) {
+	type args struct {
+		src any
+	}
+	type expected struct {
+		value int64
+		some  bool
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "value",
+			args:     args{src: int64(42)},
+			expected: expected{value: 42, some: true},
+		},
+		{
+			testName: "converted value",
+			args:     args{src: []byte("7")},
+			expected: expected{value: 7, some: true},
+		},
+		{
+			testName: "null",
+			args:     args{src: nil},
+			expected: expected{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			var o Option[int64]
+			if err := o.Scan(tt.args.src); err != nil {
+				t.Fatalf("scan: %v", err)
+			}
+			v, ok := o.Get()
+			if ok != tt.expected.some || v != tt.expected.value {
+				t.Errorf("expected (%d, %v), got (%d, %v)", tt.expected.value, tt.expected.some, v, ok)
+			}
+
+			dv, err := o.Value()
+			if err != nil {
+				t.Fatalf("value: %v", err)
+			}
+			if (dv != nil) != tt.expected.some {
+				t.Errorf("expected driver value presence %v, got %v", tt.expected.some, dv)
+			}
+		})
+	}
+}
+
+func TestOptionResultConversion(t *testing.T) {
+	if v := OkOr(Some(1), "missing"); !v.IsOk() {
+		t.Errorf("expected Some to become Ok")
+	}
+	if v := OkOr(None[int](), "missing"); !v.IsErr() {
+		t.Errorf("expected None to become Err")
+	}
+	if o := ToOption(Err[int]("boom")); o.IsSome() {
+		t.Errorf("expected Err to become None")
+	}
+	if o := MapOption(ToOption(Ok[int, string](2)), func(v int) int { return v * 3 }); o.OrElse(0) != 6 {
+		t.Errorf("expected 6, got %d", o.OrElse(0))
+	}
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"context"
+	"sync"
+)
+
+// PanicErrFunc - goroutine内で回復したpanicの値をパイプラインのエラー型に変換する
+type PanicErrFunc[E any] func(recovered any) E
+
+// ParCombine - fnsを最大limit個まで並行に実行し、結果を引数の順序で結合する
+// limitが0以下の場合は同時実行数を制限しない
+// 最初にErrを返したタスクのエラーを返し、残りのタスクのctxをキャンセルする
+// タスク内のpanicはonPanicで変換したエラーとして扱う
+func ParCombine[T, E any](
+	ctx context.Context,
+	limit int,
+	onPanic PanicErrFunc[E],
+	fns ...func(context.Context) Result[T, E],
+) Result[[]T, E] {
+	return parRun(ctx, limit, len(fns), onPanic, func(ctx context.Context, i int) Result[T, E] {
+		return fns[i](ctx)
+	})
+}
+
+// ParMap - itemsの各要素にfnを最大limit個まで並行に適用し、結果を入力の順序で返す
+// エラー、キャンセル、panicの扱いはParCombineと同じ
+func ParMap[A, B, E any](
+	ctx context.Context,
+	limit int,
+	items []A,
+	onPanic PanicErrFunc[E],
+	fn func(context.Context, A) Result[B, E],
+) Result[[]B, E] {
+	return parRun(ctx, limit, len(items), onPanic, func(ctx context.Context, i int) Result[B, E] {
+		return fn(ctx, items[i])
+	})
+}
+
+// parRun - n個のタスクをworkerで並行に実行する
+// 最初のエラーが記録された後は、未開始のタスクを起動しない
+func parRun[T, E any](
+	ctx context.Context,
+	limit, n int,
+	onPanic PanicErrFunc[E],
+	task func(context.Context, int) Result[T, E],
+) Result[[]T, E] {
+	if limit <= 0 || limit > n {
+		limit = n
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr *E
+	)
+	fail := func(e E) {
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			firstErr = &e
+			cancel()
+		}
+	}
+	failed := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return firstErr != nil
+	}
+
+	values := make([]T, n)
+	sem := make(chan struct{}, limit)
+	for i := range n {
+		sem <- struct{}{}
+		if failed() {
+			<-sem
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				if v := recover(); v != nil {
+					fail(onPanic(v))
+				}
+				<-sem
+				wg.Done()
+			}()
+			task(ctx, i).Match(
+				func(v T) { values[i] = v },
+				fail,
+			)
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return Err[[]T](*firstErr)
+	}
+	return Ok[[]T, E](values)
+}
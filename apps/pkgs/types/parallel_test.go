@@ -0,0 +1,173 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParMap(t *testing.T) {
+	onPanic := func(v any) string { return fmt.Sprintf("panic: %v", v) }
+
+	type args struct {
+		limit int
+		items []int
+		fn    func(context.Context, int) Result[int, string]
+	}
+	type expected struct {
+		values []int
+		err    string
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "preserves input order",
+			args: args{
+				limit: 2,
+				items: []int{3, 1, 2},
+				fn: func(ctx context.Context, v int) Result[int, string] {
+					// 後の要素ほど早く終わるようにする
+					time.Sleep(time.Duration(v) * time.Millisecond)
+					return Ok[int, string](v * 10)
+				},
+			},
+			expected: expected{values: []int{30, 10, 20}},
+		},
+		{
+			testName: "unbounded",
+			args: args{
+				items: []int{1, 2, 3},
+				fn:    func(ctx context.Context, v int) Result[int, string] { return Ok[int, string](v) },
+			},
+			expected: expected{values: []int{1, 2, 3}},
+		},
+		{
+			testName: "empty",
+			args: args{
+				limit: 2,
+				fn:    func(ctx context.Context, v int) Result[int, string] { return Ok[int, string](v) },
+			},
+			expected: expected{values: []int{}},
+		},
+		{
+			testName: "error",
+			args: args{
+				limit: 3,
+				items: []int{1, 2, 3},
+				fn: func(ctx context.Context, v int) Result[int, string] {
+					if v == 2 {
+						return Err[int]("failed 2")
+					}
+					return Ok[int, string](v)
+				},
+			},
+			expected: expected{err: "failed 2"},
+		},
+		{
+			testName: "panic becomes error",
+			args: args{
+				limit: 2,
+				items: []int{1, 2},
+				fn: func(ctx context.Context, v int) Result[int, string] {
+					if v == 1 {
+						panic("boom")
+					}
+					return Ok[int, string](v)
+				},
+			},
+			expected: expected{err: "panic: boom"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			res := ParMap(context.Background(), tt.args.limit, tt.args.items, onPanic, tt.args.fn)
+
+			res.Match(
+				func(values []int) {
+					if tt.expected.err != "" {
+						t.Fatalf("expected error %q, got %v", tt.expected.err, values)
+					}
+					if !slices.Equal(values, tt.expected.values) {
+						t.Errorf("expected %v, got %v", tt.expected.values, values)
+					}
+				},
+				func(e string) {
+					if e != tt.expected.err {
+						t.Errorf("expected error %q, got %q", tt.expected.err, e)
+					}
+				},
+			)
+		})
+	}
+}
+
+func TestParMapLimit(t *testing.T) {
+	const limit = 2
+	var running, peak atomic.Int32
+
+	res := ParMap(context.Background(), limit, make([]int, 10), func(any) string { return "panic" },
+		func(ctx context.Context, _ int) Result[int, string] {
+			n := running.Add(1)
+			defer running.Add(-1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			return Ok[int, string](0)
+		},
+	)
+
+	if res.IsErr() {
+		t.Fatal("expected Ok")
+	}
+	if peak.Load() > limit {
+		t.Errorf("expected at most %d concurrent tasks, got %d", limit, peak.Load())
+	}
+}
+
+func TestParCombineCancelsSiblings(t *testing.T) {
+	var started atomic.Int32
+	slow := func(ctx context.Context) Result[int, string] {
+		started.Add(1)
+		select {
+		case <-ctx.Done():
+			return Err[int]("canceled")
+		case <-time.After(time.Second):
+			return Ok[int, string](1)
+		}
+	}
+	failing := func(ctx context.Context) Result[int, string] {
+		started.Add(1)
+		return Err[int]("failed")
+	}
+
+	begin := time.Now()
+	res := ParCombine(context.Background(), 2, func(any) string { return "panic" },
+		slow, failing, slow, slow)
+
+	res.Match(
+		func(values []int) { t.Fatalf("expected error, got %v", values) },
+		func(e string) {
+			if e != "failed" {
+				t.Errorf("expected first error %q, got %q", "failed", e)
+			}
+		},
+	)
+	if elapsed := time.Since(begin); elapsed >= time.Second {
+		t.Errorf("expected siblings to be canceled, took %v", elapsed)
+	}
+	if n := started.Load(); n != 2 {
+		t.Errorf("expected tasks after the failure not to start, %d started", n)
+	}
+}
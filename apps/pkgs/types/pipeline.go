@@ -0,0 +1,168 @@
+package types
+
+import (
+	"context"
+	"fmt"
+)
+
+// Span - パイプラインの1ステップを表すトレーシングのスパン
+type Span interface {
+	// End - ステップの終了を記録する。errはステップが返したエラー、成功時はnil
+	End(err any)
+}
+
+// Tracer - ステップごとにスパンを開始する。OpenTelemetryなどへの薄いアダプタを想定
+type Tracer interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Pipeline - Resultに対してステップを順に適用するビルダー
+// 各ステップは呼び出した時点で評価され、Errになった後のステップは実行されない
+// Goのメソッドは型パラメータを持てないため、値の型が変わるステップは
+// メソッドではなくChain/ChainCtx/MapPipe関数で追加する
+//
+//	res := types.Chain(
+//		types.From(parse(req)).WithContext(ctx, onDone).Named("validate").Then(validate),
+//		find,
+//	).Tap(audit).Recover(fallback).Result()
+type Pipeline[T, E any] struct {
+	ctx    context.Context
+	onDone CtxErrFunc[E]
+	tracer Tracer
+	steps  int
+	name   string
+	result Result[T, E]
+}
+
+// From - rから始まるパイプラインを作る
+func From[T, E any](r Result[T, E]) Pipeline[T, E] {
+	return Pipeline[T, E]{ctx: context.Background(), result: r}
+}
+
+// WithContext - 以降のステップにctxを渡し、ctxが終了していればステップを実行せずonDoneのエラーにする
+func (p Pipeline[T, E]) WithContext(ctx context.Context, onDone CtxErrFunc[E]) Pipeline[T, E] {
+	p.ctx, p.onDone = ctx, onDone
+	return p
+}
+
+// WithTracer - 以降のステップごとにtracerでスパンを作る
+func (p Pipeline[T, E]) WithTracer(tracer Tracer) Pipeline[T, E] {
+	p.tracer = tracer
+	return p
+}
+
+// Named - 次のステップのスパン名を設定する。未設定のステップは"step N"になる
+func (p Pipeline[T, E]) Named(name string) Pipeline[T, E] {
+	p.name = name
+	return p
+}
+
+// Result - パイプラインの結果を返す
+func (p Pipeline[T, E]) Result() Result[T, E] {
+	return p.result
+}
+
+// Then - 値の型を変えない失敗しうるステップを追加する
+func (p Pipeline[T, E]) Then(fn func(T) Result[T, E]) Pipeline[T, E] {
+	return Chain(p, fn)
+}
+
+// ThenCtx - contextを受け取るThen。スパンを開始した場合はそのcontextを渡す
+func (p Pipeline[T, E]) ThenCtx(fn func(context.Context, T) Result[T, E]) Pipeline[T, E] {
+	return ChainCtx(p, fn)
+}
+
+// Tap - Okの場合に値を使って副作用を実行する。結果は変えない
+func (p Pipeline[T, E]) Tap(fn func(T)) Pipeline[T, E] {
+	if p.result.err == nil {
+		fn(*p.result.value)
+	}
+	return p
+}
+
+// TapErr - Errの場合にエラーを使って副作用を実行する。結果は変えない
+func (p Pipeline[T, E]) TapErr(fn func(E)) Pipeline[T, E] {
+	if p.result.err != nil {
+		fn(*p.result.err)
+	}
+	return p
+}
+
+// Recover - Errの場合にhandlerで回復を試みる。handlerがOkを返せば以降のステップが続行される
+func (p Pipeline[T, E]) Recover(handler func(E) Result[T, E]) Pipeline[T, E] {
+	if p.result.err == nil {
+		return p
+	}
+	_, span := p.startSpan(p.stepName())
+	r := handler(*p.result.err)
+	p.endSpan(span, r)
+	p.result = r
+	return p
+}
+
+// Chain - 値の型が変わる失敗しうるステップを追加する
+func Chain[T, U, E any](p Pipeline[T, E], fn func(T) Result[U, E]) Pipeline[U, E] {
+	return ChainCtx(p, func(_ context.Context, v T) Result[U, E] {
+		return fn(v)
+	})
+}
+
+// ChainCtx - contextを受け取るChain
+func ChainCtx[T, U, E any](p Pipeline[T, E], fn func(context.Context, T) Result[U, E]) Pipeline[U, E] {
+	name := p.stepName()
+	next := Pipeline[U, E]{ctx: p.ctx, onDone: p.onDone, tracer: p.tracer, steps: p.steps}
+
+	if p.result.err != nil {
+		next.result = Err[U](*p.result.err)
+		return next
+	}
+	if p.onDone != nil {
+		if e, done := checkCtx(p.ctx, p.onDone); done {
+			next.result = Err[U](e)
+			return next
+		}
+	}
+
+	ctx, span := p.startSpan(name)
+	next.result = fn(ctx, *p.result.value)
+	next.endSpan(span, next.result)
+	return next
+}
+
+// MapPipe - 値の型が変わる失敗しないステップを追加する
+func MapPipe[T, U, E any](p Pipeline[T, E], fn func(T) U) Pipeline[U, E] {
+	return Chain(p, func(v T) Result[U, E] {
+		return Ok[U, E](fn(v))
+	})
+}
+
+// stepName - 次のステップの名前を決め、ステップ数を進める
+func (p *Pipeline[T, E]) stepName() string {
+	p.steps++
+	name := p.name
+	if name == "" {
+		name = fmt.Sprintf("step %d", p.steps)
+	}
+	p.name = ""
+	return name
+}
+
+// startSpan - tracerが設定されていればスパンを開始する
+func (p Pipeline[T, E]) startSpan(name string) (context.Context, Span) {
+	if p.tracer == nil {
+		return p.ctx, nil
+	}
+	return p.tracer.Start(p.ctx, name)
+}
+
+// endSpan - ステップの結果でスパンを終了する
+func (p Pipeline[T, E]) endSpan(span Span, r Result[T, E]) {
+	if span == nil {
+		return
+	}
+	if r.err != nil {
+		span.End(*r.err)
+		return
+	}
+	span.End(nil)
+}
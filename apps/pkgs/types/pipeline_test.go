@@ -0,0 +1,187 @@
+package types
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// fakeTracer - 開始したスパンの名前と終了時のエラーを記録するTracer
+type fakeTracer struct {
+	spans []string
+}
+
+type fakeSpan struct {
+	tracer *fakeTracer
+	name   string
+}
+
+func (t *fakeTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	return ctx, fakeSpan{tracer: t, name: name}
+}
+
+func (s fakeSpan) End(err any) {
+	if err != nil {
+		s.tracer.spans = append(s.tracer.spans, s.name+" failed")
+		return
+	}
+	s.tracer.spans = append(s.tracer.spans, s.name)
+}
+
+func TestPipeline(t *testing.T) {
+	parse := func(s string) Result[int, string] {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return Err[int]("invalid " + s)
+		}
+		return Ok[int, string](n)
+	}
+	positive := func(n int) Result[int, string] {
+		if n <= 0 {
+			return Err[int]("not positive")
+		}
+		return Ok[int, string](n)
+	}
+
+	type args struct {
+		input   string
+		recover func(string) Result[int, string]
+	}
+	type expected struct {
+		value  string
+		err    string
+		spans  []string
+		tapped []string
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "every step runs",
+			args:     args{input: "3"},
+			expected: expected{
+				value:  "6",
+				spans:  []string{"parse", "step 2", "double", "format"},
+				tapped: []string{"ok 6"},
+			},
+		},
+		{
+			testName: "error skips later steps",
+			args:     args{input: "x"},
+			expected: expected{
+				err:    "invalid x",
+				spans:  []string{"parse failed", "fallback failed"},
+				tapped: []string{"err invalid x"},
+			},
+		},
+		{
+			testName: "recovered error continues",
+			args: args{
+				input:   "-1",
+				recover: func(e string) Result[int, string] { return Ok[int, string](0) },
+			},
+			expected: expected{
+				value:  "0",
+				spans:  []string{"parse", "step 2 failed", "fallback", "format"},
+				tapped: []string{"err not positive"},
+			},
+		},
+		{
+			testName: "fallible last step",
+			args: args{
+				input:   "-1",
+				recover: func(e string) Result[int, string] { return Err[int]("still " + e) },
+			},
+			expected: expected{
+				err:    "still not positive",
+				spans:  []string{"parse", "step 2 failed", "fallback failed"},
+				tapped: []string{"err not positive"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			tracer := &fakeTracer{}
+			var tapped []string
+			fallback := tt.args.recover
+			if fallback == nil {
+				fallback = func(e string) Result[int, string] { return Err[int](e) }
+			}
+
+			p := Chain(
+				From(Ok[string, string](tt.args.input)).WithTracer(tracer).Named("parse"),
+				parse,
+			).
+				Then(positive).
+				Named("double").
+				Then(func(n int) Result[int, string] { return Ok[int, string](n * 2) }).
+				Tap(func(n int) { tapped = append(tapped, "ok "+strconv.Itoa(n)) }).
+				TapErr(func(e string) { tapped = append(tapped, "err "+e) }).
+				Named("fallback").
+				Recover(fallback)
+			res := Chain(p.Named("format"), func(n int) Result[string, string] {
+				return Ok[string, string](strconv.Itoa(n))
+			}).Result()
+
+			res.Match(
+				func(v string) {
+					if tt.expected.err != "" {
+						t.Fatalf("expected error %q, got %q", tt.expected.err, v)
+					}
+					if v != tt.expected.value {
+						t.Errorf("expected %q, got %q", tt.expected.value, v)
+					}
+				},
+				func(e string) {
+					if e != tt.expected.err {
+						t.Errorf("expected error %q, got %q", tt.expected.err, e)
+					}
+				},
+			)
+			if !slices.Equal(tracer.spans, tt.expected.spans) {
+				t.Errorf("expected spans %v, got %v", tt.expected.spans, tracer.spans)
+			}
+			if !slices.Equal(tapped, tt.expected.tapped) {
+				t.Errorf("expected taps %v, got %v", tt.expected.tapped, tapped)
+			}
+		})
+	}
+}
+
+func TestPipelineContext(t *testing.T) {
+	onDone := func(cause error) string { return "done: " + cause.Error() }
+	ctx, cancel := context.WithCancel(context.Background())
+
+	called := 0
+	res := MapPipe(
+		From(Ok[int, string](1)).
+			WithContext(ctx, onDone).
+			ThenCtx(func(ctx context.Context, n int) Result[int, string] {
+				called++
+				cancel()
+				return Ok[int, string](n + 1)
+			}).
+			ThenCtx(func(ctx context.Context, n int) Result[int, string] {
+				called++
+				return Ok[int, string](n + 1)
+			}),
+		strconv.Itoa,
+	).Result()
+
+	res.Match(
+		func(v string) { t.Fatalf("expected error, got %q", v) },
+		func(e string) {
+			if e != "done: "+context.Canceled.Error() {
+				t.Errorf("expected cancellation error, got %q", e)
+			}
+		},
+	)
+	if called != 1 {
+		t.Errorf("expected steps after cancellation to be skipped, %d ran", called)
+	}
+}
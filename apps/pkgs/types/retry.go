@@ -0,0 +1,146 @@
+package types
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+)
+
+// Clock - 待機に使う時計。テストでは即座に進む実装に差し替える
+type Clock interface {
+	After(d time.Duration) <-chan time.Time
+}
+
+// systemClock - timeパッケージを使うClock
+type systemClock struct{}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// SystemClock - 実時間で待機するClock
+var SystemClock Clock = systemClock{}
+
+// RetryPolicy - Retryの再試行方法
+type RetryPolicy[E any] struct {
+	// MaxAttempts - 最初の呼び出しを含む最大試行回数。1以下の場合は再試行しない
+	MaxAttempts int
+	// InitialBackoff - 1回目の再試行までの待機時間
+	InitialBackoff time.Duration
+	// MaxBackoff - 待機時間の上限。0の場合は上限なし
+	MaxBackoff time.Duration
+	// Multiplier - 再試行ごとに待機時間に掛ける倍率。1未満の場合は2
+	Multiplier float64
+	// Jitter - 待機時間をランダムに短縮する割合(0〜1)。0.5なら待機時間の50%〜100%になる
+	Jitter float64
+	// ShouldRetry - エラーが再試行可能かを判定する。nilの場合はすべて再試行する
+	ShouldRetry func(E) bool
+	// OnDone - 再試行の前や待機中にctxが終了した場合のエラーに変換する。nilの場合は最後のエラーを返す
+	OnDone CtxErrFunc[E]
+	// Clock - 待機に使う時計。nilの場合はSystemClock
+	Clock Clock
+	// Rand - [0, 1)の乱数を返す。nilの場合はmath/rand/v2
+	Rand func() float64
+}
+
+// Backoff - attempt回目(1始まり)の失敗後に待機する時間
+func (p RetryPolicy[E]) Backoff(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+	d := float64(p.InitialBackoff)
+	for range attempt - 1 {
+		d *= multiplier
+		if p.MaxBackoff > 0 && d >= float64(p.MaxBackoff) {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		random := p.Rand
+		if random == nil {
+			random = rand.Float64
+		}
+		d -= d * min(p.Jitter, 1) * random()
+	}
+	return time.Duration(d)
+}
+
+// Retry - fnがErrを返した場合、policyに従って待機してから再試行する
+// 再試行できないエラー、試行回数の上限、ctxの終了のいずれかで最後の結果を返す
+func Retry[T, E any](ctx context.Context, policy RetryPolicy[E], fn func(context.Context) Result[T, E]) Result[T, E] {
+	clock := policy.Clock
+	if clock == nil {
+		clock = SystemClock
+	}
+
+	for attempt := 1; ; attempt++ {
+		r := fn(ctx)
+		if r.err == nil || attempt >= policy.MaxAttempts {
+			return r
+		}
+		if policy.ShouldRetry != nil && !policy.ShouldRetry(*r.err) {
+			return r
+		}
+
+		if ctx.Err() == nil {
+			select {
+			case <-ctx.Done():
+			case <-clock.After(policy.Backoff(attempt)):
+				continue
+			}
+		}
+		if policy.OnDone != nil {
+			return Err[T](policy.OnDone(context.Cause(ctx)))
+		}
+		return r
+	}
+}
+
+// WithTimeout - 期限付きのctxでfnを実行する
+// 期限切れでfnがErrを返した場合は、そのエラーをonDoneで変換したエラーに置き換える
+func WithTimeout[T, E any](
+	ctx context.Context,
+	timeout time.Duration,
+	onDone CtxErrFunc[E],
+	fn func(context.Context) Result[T, E],
+) Result[T, E] {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r := fn(ctx)
+	if r.err != nil && ctx.Err() != nil {
+		return Err[T](onDone(context.Cause(ctx)))
+	}
+	return r
+}
+
+// Retrying - RetryをPipe2〜Pipe5のステップとして使える関数に変換する
+func Retrying[A, B, E any](
+	ctx context.Context,
+	policy RetryPolicy[E],
+	fn func(context.Context, A) Result[B, E],
+) func(A) Result[B, E] {
+	return func(v A) Result[B, E] {
+		return Retry(ctx, policy, func(ctx context.Context) Result[B, E] {
+			return fn(ctx, v)
+		})
+	}
+}
+
+// Timed - WithTimeoutをPipe2〜Pipe5のステップとして使える関数に変換する
+func Timed[A, B, E any](
+	ctx context.Context,
+	timeout time.Duration,
+	onDone CtxErrFunc[E],
+	fn func(context.Context, A) Result[B, E],
+) func(A) Result[B, E] {
+	return func(v A) Result[B, E] {
+		return WithTimeout(ctx, timeout, onDone, func(ctx context.Context) Result[B, E] {
+			return fn(ctx, v)
+		})
+	}
+}
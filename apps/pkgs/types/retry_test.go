@@ -0,0 +1,218 @@
+package types
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+// fakeClock - 待機せずに待機時間を記録するClock
+type fakeClock struct {
+	waits []time.Duration
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.waits = append(c.waits, d)
+	ch := make(chan time.Time, 1)
+	ch <- time.Time{}
+	return ch
+}
+
+func TestRetry(t *testing.T) {
+	type args struct {
+		policy   RetryPolicy[string]
+		failures int
+		errMsg   string
+	}
+	type expected struct {
+		value int
+		err   string
+		calls int
+		waits []time.Duration
+	}
+
+	base := RetryPolicy[string]{
+		MaxAttempts:    4,
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     25 * time.Millisecond,
+	}
+	withJitter := base
+	withJitter.Jitter = 0.5
+	withJitter.Rand = func() float64 { return 0.5 }
+	onlyTransient := base
+	onlyTransient.ShouldRetry = func(e string) bool { return e == "transient" }
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "succeeds first time",
+			args:     args{policy: base},
+			expected: expected{value: 1, calls: 1},
+		},
+		{
+			testName: "succeeds after retries with capped exponential backoff",
+			args:     args{policy: base, failures: 3, errMsg: "transient"},
+			expected: expected{
+				value: 4,
+				calls: 4,
+				waits: []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 25 * time.Millisecond},
+			},
+		},
+		{
+			testName: "gives up after max attempts",
+			args:     args{policy: base, failures: 10, errMsg: "transient"},
+			expected: expected{
+				err:   "transient",
+				calls: 4,
+				waits: []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 25 * time.Millisecond},
+			},
+		},
+		{
+			testName: "jitter shortens the wait",
+			args:     args{policy: withJitter, failures: 1, errMsg: "transient"},
+			expected: expected{value: 2, calls: 2, waits: []time.Duration{7500 * time.Microsecond}},
+		},
+		{
+			testName: "non-retryable error",
+			args:     args{policy: onlyTransient, failures: 1, errMsg: "invalid"},
+			expected: expected{err: "invalid", calls: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			clock := &fakeClock{}
+			policy := tt.args.policy
+			policy.Clock = clock
+
+			calls := 0
+			res := Retry(context.Background(), policy, func(ctx context.Context) Result[int, string] {
+				calls++
+				if calls <= tt.args.failures {
+					return Err[int](tt.args.errMsg)
+				}
+				return Ok[int, string](calls)
+			})
+
+			res.Match(
+				func(v int) {
+					if tt.expected.err != "" {
+						t.Fatalf("expected error %q, got %d", tt.expected.err, v)
+					}
+					if v != tt.expected.value {
+						t.Errorf("expected %d, got %d", tt.expected.value, v)
+					}
+				},
+				func(e string) {
+					if e != tt.expected.err {
+						t.Errorf("expected error %q, got %q", tt.expected.err, e)
+					}
+				},
+			)
+			if calls != tt.expected.calls {
+				t.Errorf("expected %d calls, got %d", tt.expected.calls, calls)
+			}
+			if !slices.Equal(clock.waits, tt.expected.waits) {
+				t.Errorf("expected waits %v, got %v", tt.expected.waits, clock.waits)
+			}
+		})
+	}
+}
+
+func TestRetryCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	policy := RetryPolicy[string]{
+		MaxAttempts: 3,
+		OnDone:      func(cause error) string { return "done: " + cause.Error() },
+		Clock:       &fakeClock{},
+	}
+
+	res := Retry(ctx, policy, func(ctx context.Context) Result[int, string] {
+		cancel()
+		return Err[int]("transient")
+	})
+
+	res.Match(
+		func(v int) { t.Fatalf("expected error, got %d", v) },
+		func(e string) {
+			if e != "done: "+context.Canceled.Error() {
+				t.Errorf("expected cancellation error, got %q", e)
+			}
+		},
+	)
+}
+
+func TestWithTimeout(t *testing.T) {
+	onDone := func(cause error) string { return "done: " + cause.Error() }
+
+	type args struct {
+		fn func(context.Context) Result[int, string]
+	}
+	type expected struct {
+		value int
+		err   string
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "finishes in time",
+			args: args{
+				fn: func(ctx context.Context) Result[int, string] { return Ok[int, string](1) },
+			},
+			expected: expected{value: 1},
+		},
+		{
+			testName: "error before the deadline is kept",
+			args: args{
+				fn: func(ctx context.Context) Result[int, string] { return Err[int]("invalid") },
+			},
+			expected: expected{err: "invalid"},
+		},
+		{
+			testName: "deadline exceeded",
+			args: args{
+				fn: func(ctx context.Context) Result[int, string] {
+					<-ctx.Done()
+					return Err[int](ctx.Err().Error())
+				},
+			},
+			expected: expected{err: "done: " + context.DeadlineExceeded.Error()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			res := Pipe2(
+				Ok[int, string](0),
+				Timed(context.Background(), 10*time.Millisecond, onDone, func(ctx context.Context, _ int) Result[int, string] {
+					return tt.args.fn(ctx)
+				}),
+				func(v int) int { return v },
+			)
+
+			res.Match(
+				func(v int) {
+					if tt.expected.err != "" {
+						t.Fatalf("expected error %q, got %d", tt.expected.err, v)
+					}
+					if v != tt.expected.value {
+						t.Errorf("expected %d, got %d", tt.expected.value, v)
+					}
+				},
+				func(e string) {
+					if e != tt.expected.err {
+						t.Errorf("expected error %q, got %q", tt.expected.err, e)
+					}
+				},
+			)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package types
+
+import "slices"
+
+// MapSlice - スライスの各要素にfnを適用した新しいスライスを返す
+func MapSlice[A, B any](xs []A, fn func(A) B) []B {
+	ys := make([]B, len(xs))
+	for i, x := range xs {
+		ys[i] = fn(x)
+	}
+	return ys
+}
+
+// Traverse - スライスの各要素に失敗しうるfnを順に適用する
+// 最初のErrで処理を止めてそのエラーを返し、すべて成功すれば結果のスライスを返す
+func Traverse[A, B, E any](xs []A, fn func(A) Result[B, E]) Result[[]B, E] {
+	ys := make([]B, len(xs))
+	for i, x := range xs {
+		r := fn(x)
+		if r.err != nil {
+			return Err[[]B](*r.err)
+		}
+		ys[i] = *r.value
+	}
+	return Ok[[]B, E](ys)
+}
+
+// Sequence - Resultのスライスを、スライスのResultに変換する
+// 最初のErrを返す点はCombineと同じだが、結果のスライスを一度だけ確保する
+func Sequence[T, E any](rs []Result[T, E]) Result[[]T, E] {
+	return Traverse(rs, func(r Result[T, E]) Result[T, E] { return r })
+}
+
+// Partition - Resultのスライスを成功値とエラーに分ける。それぞれ元の順序を保つ
+func Partition[T, E any](rs []Result[T, E]) ([]T, []E) {
+	var values []T
+	var errs []E
+	for _, r := range rs {
+		if r.err != nil {
+			errs = append(errs, *r.err)
+		} else {
+			values = append(values, *r.value)
+		}
+	}
+	return values, errs
+}
+
+// Filter - predがtrueを返す要素だけを持つ新しいスライスを返す
+func Filter[T any](xs []T, pred func(T) bool) []T {
+	var ys []T
+	for _, x := range xs {
+		if pred(x) {
+			ys = append(ys, x)
+		}
+	}
+	return ys
+}
+
+// FoldLeft - 初期値zeroから左端の要素順にfnで畳み込む
+func FoldLeft[T, U any](xs []T, zero U, fn func(U, T) U) U {
+	acc := zero
+	for _, x := range xs {
+		acc = fn(acc, x)
+	}
+	return acc
+}
+
+// GroupBy - keyが返すキーごとに要素をまとめる。各グループ内は元の順序を保つ
+func GroupBy[T any, K comparable](xs []T, key func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for _, x := range xs {
+		k := key(x)
+		groups[k] = append(groups[k], x)
+	}
+	return groups
+}
+
+// Sort - cmpの順に並べ替えたコピーを返す。元のスライスは変更しない
+// 並べ替えは安定で、cmpが0を返す要素は元の順序を保つ
+func Sort[T any](xs []T, cmp func(a, b T) int) []T {
+	ys := slices.Clone(xs)
+	slices.SortStableFunc(ys, cmp)
+	return ys
+}
@@ -0,0 +1,220 @@
+package types
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestTraverse(t *testing.T) {
+	parse := func(s string) Result[int, string] {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return Err[int]("invalid " + s)
+		}
+		return Ok[int, string](n)
+	}
+
+	type args struct {
+		xs []string
+	}
+	type expected struct {
+		values []int
+		err    string
+		calls  int
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "all succeed",
+			args:     args{xs: []string{"1", "2", "3"}},
+			expected: expected{values: []int{1, 2, 3}, calls: 3},
+		},
+		{
+			testName: "empty",
+			args:     args{},
+			expected: expected{values: []int{}},
+		},
+		{
+			testName: "stops at first error",
+			args:     args{xs: []string{"1", "x", "y"}},
+			expected: expected{err: "invalid x", calls: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			calls := 0
+			res := Traverse(tt.args.xs, func(s string) Result[int, string] {
+				calls++
+				return parse(s)
+			})
+
+			res.Match(
+				func(values []int) {
+					if tt.expected.err != "" {
+						t.Fatalf("expected error %q, got %v", tt.expected.err, values)
+					}
+					if !slices.Equal(values, tt.expected.values) {
+						t.Errorf("expected %v, got %v", tt.expected.values, values)
+					}
+				},
+				func(e string) {
+					if e != tt.expected.err {
+						t.Errorf("expected error %q, got %q", tt.expected.err, e)
+					}
+				},
+			)
+			if calls != tt.expected.calls {
+				t.Errorf("expected %d calls, got %d", tt.expected.calls, calls)
+			}
+		})
+	}
+}
+
+func TestSequence(t *testing.T) {
+	ok := Sequence([]Result[int, string]{Ok[int, string](1), Ok[int, string](2)})
+	if v := ok.UnwrapOr(nil); !slices.Equal(v, []int{1, 2}) {
+		t.Errorf("expected [1 2], got %v", v)
+	}
+
+	failed := Sequence([]Result[int, string]{Ok[int, string](1), Err[int]("a"), Err[int]("b")})
+	failed.Match(
+		func(values []int) { t.Fatalf("expected error, got %v", values) },
+		func(e string) {
+			if e != "a" {
+				t.Errorf("expected first error %q, got %q", "a", e)
+			}
+		},
+	)
+}
+
+func TestPartition(t *testing.T) {
+	values, errs := Partition([]Result[int, string]{
+		Ok[int, string](1),
+		Err[int]("a"),
+		Ok[int, string](2),
+		Err[int]("b"),
+	})
+
+	if !slices.Equal(values, []int{1, 2}) {
+		t.Errorf("expected values [1 2], got %v", values)
+	}
+	if !slices.Equal(errs, []string{"a", "b"}) {
+		t.Errorf("expected errors [a b], got %v", errs)
+	}
+}
+
+func TestSliceHelpers(t *testing.T) {
+	xs := []int{5, 2, 8, 1, 4}
+
+	if got := Filter(xs, func(x int) bool { return x%2 == 0 }); !slices.Equal(got, []int{2, 8, 4}) {
+		t.Errorf("Filter: expected [2 8 4], got %v", got)
+	}
+
+	if got := FoldLeft(xs, "", func(acc string, x int) string { return acc + strconv.Itoa(x) }); got != "52814" {
+		t.Errorf("FoldLeft: expected 52814, got %s", got)
+	}
+
+	groups := GroupBy(xs, func(x int) bool { return x > 3 })
+	want := map[bool][]int{true: {5, 8, 4}, false: {2, 1}}
+	if !maps.EqualFunc(groups, want, slices.Equal) {
+		t.Errorf("GroupBy: expected %v, got %v", want, groups)
+	}
+
+	sorted := Sort(xs, cmp.Compare[int])
+	if !slices.Equal(sorted, []int{1, 2, 4, 5, 8}) {
+		t.Errorf("Sort: expected [1 2 4 5 8], got %v", sorted)
+	}
+	if !slices.Equal(xs, []int{5, 2, 8, 1, 4}) {
+		t.Errorf("Sort: expected input to be unchanged, got %v", xs)
+	}
+
+	if got := MapSlice(xs, strconv.Itoa); !slices.Equal(got, []string{"5", "2", "8", "1", "4"}) {
+		t.Errorf("MapSlice: expected strings, got %v", got)
+	}
+}
+
+func benchmarkInput(n int) []int {
+	xs := make([]int, n)
+	for i := range xs {
+		xs[i] = n - i
+	}
+	return xs
+}
+
+func BenchmarkTraverse(b *testing.B) {
+	xs := benchmarkInput(1000)
+	fn := func(x int) Result[int, string] { return Ok[int, string](x * 2) }
+	b.ReportAllocs()
+	for b.Loop() {
+		Traverse(xs, fn)
+	}
+}
+
+func BenchmarkSequence(b *testing.B) {
+	rs := MapSlice(benchmarkInput(1000), Ok[int, string])
+	b.ReportAllocs()
+	for b.Loop() {
+		Sequence(rs)
+	}
+}
+
+func BenchmarkCombine(b *testing.B) {
+	rs := MapSlice(benchmarkInput(1000), Ok[int, string])
+	b.ReportAllocs()
+	for b.Loop() {
+		Combine(rs...)
+	}
+}
+
+func BenchmarkPartition(b *testing.B) {
+	rs := MapSlice(benchmarkInput(1000), func(x int) Result[int, string] {
+		if x%2 == 0 {
+			return Err[int]("even")
+		}
+		return Ok[int, string](x)
+	})
+	b.ReportAllocs()
+	for b.Loop() {
+		Partition(rs)
+	}
+}
+
+func BenchmarkFilter(b *testing.B) {
+	xs := benchmarkInput(1000)
+	b.ReportAllocs()
+	for b.Loop() {
+		Filter(xs, func(x int) bool { return x%2 == 0 })
+	}
+}
+
+func BenchmarkFoldLeft(b *testing.B) {
+	xs := benchmarkInput(1000)
+	b.ReportAllocs()
+	for b.Loop() {
+		FoldLeft(xs, 0, func(acc, x int) int { return acc + x })
+	}
+}
+
+func BenchmarkGroupBy(b *testing.B) {
+	xs := benchmarkInput(1000)
+	b.ReportAllocs()
+	for b.Loop() {
+		GroupBy(xs, func(x int) int { return x % 10 })
+	}
+}
+
+func BenchmarkSort(b *testing.B) {
+	xs := benchmarkInput(1000)
+	b.ReportAllocs()
+	for b.Loop() {
+		Sort(xs, cmp.Compare[int])
+	}
+}
@@ -0,0 +1,46 @@
+package types
+
+// Tuple2〜Tuple4 - パイプラインで複数の値をまとめて受け渡すための組
+type Tuple2[A, B any] struct {
+	V1 A
+	V2 B
+}
+
+type Tuple3[A, B, C any] struct {
+	V1 A
+	V2 B
+	V3 C
+}
+
+type Tuple4[A, B, C, D any] struct {
+	V1 A
+	V2 B
+	V3 C
+	V4 D
+}
+
+// コンストラクタ
+func NewTuple2[A, B any](a A, b B) Tuple2[A, B] {
+	return Tuple2[A, B]{V1: a, V2: b}
+}
+
+func NewTuple3[A, B, C any](a A, b B, c C) Tuple3[A, B, C] {
+	return Tuple3[A, B, C]{V1: a, V2: b, V3: c}
+}
+
+func NewTuple4[A, B, C, D any](a A, b B, c C, d D) Tuple4[A, B, C, D] {
+	return Tuple4[A, B, C, D]{V1: a, V2: b, V3: c, V4: d}
+}
+
+// Unpack - 要素を多値として返す
+func (t Tuple2[A, B]) Unpack() (A, B) {
+	return t.V1, t.V2
+}
+
+func (t Tuple3[A, B, C]) Unpack() (A, B, C) {
+	return t.V1, t.V2, t.V3
+}
+
+func (t Tuple4[A, B, C, D]) Unpack() (A, B, C, D) {
+	return t.V1, t.V2, t.V3, t.V4
+}
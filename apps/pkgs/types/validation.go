@@ -0,0 +1,127 @@
+package types
+
+// Validation型 - 最初のエラーで止まらず、すべてのエラーを蓄積するResult
+// 独立した入力の検証結果をZipで組み合わせるために使う
+type Validation[T any, E any] struct {
+	value T
+	errs  []E
+}
+
+// コンストラクタ
+func Valid[T any, E any](value T) Validation[T, E] {
+	return Validation[T, E]{value: value}
+}
+
+func Invalid[T any, E any](errs ...E) Validation[T, E] {
+	return Validation[T, E]{errs: errs}
+}
+
+// ToValidation - ResultをValidationに変換する
+func ToValidation[T, E any](r Result[T, E]) Validation[T, E] {
+	if r.err != nil {
+		return Invalid[T](*r.err)
+	}
+	return Valid[T, E](*r.value)
+}
+
+// 判定メソッド
+func (v Validation[T, E]) IsValid() bool {
+	return len(v.errs) == 0
+}
+
+// Errors - 蓄積されたエラーを発生順に返す
+func (v Validation[T, E]) Errors() []E {
+	return v.errs
+}
+
+// ToResult - 有効ならOk、そうでなければ全エラーを持つErrに変換する
+func (v Validation[T, E]) ToResult() Result[T, []E] {
+	if len(v.errs) > 0 {
+		return Err[T](v.errs)
+	}
+	return Ok[T, []E](v.value)
+}
+
+func MapValidation[T, U, E any](v Validation[T, E], fn func(T) U) Validation[U, E] {
+	if len(v.errs) > 0 {
+		return Invalid[U](v.errs...)
+	}
+	return Valid[U, E](fn(v.value))
+}
+
+// CombineAll - Combineと異なり、すべてのエラーを蓄積する
+func CombineAll[T, E any](vs ...Validation[T, E]) Validation[[]T, E] {
+	values := make([]T, 0, len(vs))
+	var errs []E
+	for _, v := range vs {
+		errs = append(errs, v.errs...)
+		values = append(values, v.value)
+	}
+	if len(errs) > 0 {
+		return Invalid[[]T](errs...)
+	}
+	return Valid[[]T, E](values)
+}
+
+// collectErrors - 複数のValidationのエラーを順に連結する
+func collectErrors[E any](errs ...[]E) []E {
+	var all []E
+	for _, e := range errs {
+		all = append(all, e...)
+	}
+	return all
+}
+
+// Zip2 - 2つのValidationがすべて有効ならfnで結合し、そうでなければ全エラーを返す
+func Zip2[A, B, C, E any](
+	va Validation[A, E],
+	vb Validation[B, E],
+	fn func(A, B) C,
+) Validation[C, E] {
+	if errs := collectErrors(va.errs, vb.errs); len(errs) > 0 {
+		return Invalid[C](errs...)
+	}
+	return Valid[C, E](fn(va.value, vb.value))
+}
+
+// Zip3 - 3つのValidationを結合する
+func Zip3[A, B, C, D, E any](
+	va Validation[A, E],
+	vb Validation[B, E],
+	vc Validation[C, E],
+	fn func(A, B, C) D,
+) Validation[D, E] {
+	if errs := collectErrors(va.errs, vb.errs, vc.errs); len(errs) > 0 {
+		return Invalid[D](errs...)
+	}
+	return Valid[D, E](fn(va.value, vb.value, vc.value))
+}
+
+// Zip4 - 4つのValidationを結合する
+func Zip4[A, B, C, D, F, E any](
+	va Validation[A, E],
+	vb Validation[B, E],
+	vc Validation[C, E],
+	vd Validation[D, E],
+	fn func(A, B, C, D) F,
+) Validation[F, E] {
+	if errs := collectErrors(va.errs, vb.errs, vc.errs, vd.errs); len(errs) > 0 {
+		return Invalid[F](errs...)
+	}
+	return Valid[F, E](fn(va.value, vb.value, vc.value, vd.value))
+}
+
+// Zip5 - 5つのValidationを結合する
+func Zip5[A, B, C, D, F, G, E any](
+	va Validation[A, E],
+	vb Validation[B, E],
+	vc Validation[C, E],
+	vd Validation[D, E],
+	vf Validation[F, E],
+	fn func(A, B, C, D, F) G,
+) Validation[G, E] {
+	if errs := collectErrors(va.errs, vb.errs, vc.errs, vd.errs, vf.errs); len(errs) > 0 {
+		return Invalid[G](errs...)
+	}
+	return Valid[G, E](fn(va.value, vb.value, vc.value, vd.value, vf.value))
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestZip3(t *testing.T) {
+	type user struct {
+		name  string
+		age   int
+		email string
+	}
+
+	type args struct {
+		name  Validation[string, string]
+		age   Validation[int, string]
+		email Validation[string, string]
+	}
+	type expected struct {
+		user user
+		errs []string
+	}
+
+	tests := []struct {
+		testName string
+		args     args
+		expected expected
+	}{
+		{
+			testName: "all valid",
+			args: args{
+				name:  Valid[string, string]("ann"),
+				age:   Valid[int, string](30),
+				email: Valid[string, string]("ann@example.com"),
+			},
+			expected: expected{user: user{"ann", 30, "ann@example.com"}},
+		},
+		{
+			testName: "every error in order",
+			args: args{
+				name:  Invalid[string]("name required"),
+				age:   Valid[int, string](30),
+				email: Invalid[string]("email invalid", "email too long"),
+			},
+			expected: expected{errs: []string{"name required", "email invalid", "email too long"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			v := Zip3(tt.args.name, tt.args.age, tt.args.email, func(name string, age int, email string) user {
+				return user{name, age, email}
+			})
+
+			v.ToResult().Match(
+				func(u user) {
+					if tt.expected.errs != nil {
+						t.Fatalf("expected errors %v, got %+v", tt.expected.errs, u)
+					}
+					if u != tt.expected.user {
+						t.Errorf("expected %+v, got %+v", tt.expected.user, u)
+					}
+				},
+				func(errs []string) {
+					if !slices.Equal(errs, tt.expected.errs) {
+						t.Errorf("expected errors %v, got %v", tt.expected.errs, errs)
+					}
+				},
+			)
+		})
+	}
+}
+
+func TestCombineAll(t *testing.T) {
+	v := CombineAll(
+		ToValidation(Ok[int, string](1)),
+		ToValidation(Err[int]("a")),
+		ToValidation(Err[int]("b")),
+	)
+	if v.IsValid() || !slices.Equal(v.Errors(), []string{"a", "b"}) {
+		t.Errorf("expected errors [a b], got %v", v.Errors())
+	}
+
+	ok := CombineAll(Valid[int, string](1), Valid[int, string](2))
+	if got := ok.ToResult().UnwrapOr(nil); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("expected [1 2], got %v", got)
+	}
+}